@@ -0,0 +1,3 @@
+// Functions for analysis of the events recorded while the disciplines are running
+// and for creation of the charts based on the analysis results.
+package analysis
@@ -1,56 +1,60 @@
-// Internal package with research functions that are used for testing.
-package research
+package analysis
 
 import (
 	"slices"
 	"sort"
 	"time"
 
-	"github.com/akramarenkov/cqos/v2/internal/qot"
-	"github.com/akramarenkov/cqos/v2/priority/internal/measurer"
+	"github.com/akramarenkov/cqos/v2/recorder"
 
 	chartsopts "github.com/go-echarts/go-echarts/v2/opts"
 )
 
-func FilterByKind(measures []measurer.Measure, kind measurer.MeasureKind) []measurer.Measure {
-	out := make([]measurer.Measure, 0, len(measures))
+// Returns events of the specified kind.
+func FilterByKind(events []recorder.Event, kind recorder.Kind) []recorder.Event {
+	out := make([]recorder.Event, 0, len(events))
 
-	for _, measure := range measures {
-		if measure.Kind != kind {
+	for _, event := range events {
+		if event.Kind != kind {
 			continue
 		}
 
-		out = append(out, measure)
+		out = append(out, event)
 	}
 
 	return out
 }
 
-func sortByData(measures []measurer.Measure) {
+func sortByData(events []recorder.Event) {
 	less := func(i int, j int) bool {
-		return measures[i].Data < measures[j].Data
+		return events[i].Data < events[j].Data
 	}
 
-	sort.SliceStable(measures, less)
+	sort.SliceStable(events, less)
 }
 
-func sortByRelativeTime(measures []measurer.Measure) {
+func sortByRelativeTime(events []recorder.Event) {
 	less := func(i int, j int) bool {
-		return measures[i].RelativeTime < measures[j].RelativeTime
+		return events[i].RelativeTime < events[j].RelativeTime
 	}
 
-	sort.SliceStable(measures, less)
+	sort.SliceStable(events, less)
 }
 
+// Calculates the quantity of events per priority in each time span of the
+// specified resolution. Usually used with events of the recorder.KindReceived
+// kind to get the quantity of data elements received by handlers over time.
+//
+// Events are sorted by relative time in place.
 func CalcDataQuantity(
-	measures []measurer.Measure,
+	events []recorder.Event,
 	resolution time.Duration,
-) map[uint][]qot.QOT {
-	if len(measures) == 0 {
+) map[uint][]QOT {
+	if len(events) == 0 {
 		return nil
 	}
 
-	sortByRelativeTime(measures)
+	sortByRelativeTime(events)
 
 	// To see the initial zero values ​​on the graph
 	min := -resolution
@@ -59,42 +63,42 @@ func CalcDataQuantity(
 	// And one more resolution added to max span value to see the final zero values ​on
 	// ​the graph
 	expansion := 2
-	max := measures[len(measures)-1].RelativeTime + time.Duration(expansion)*resolution
+	max := events[len(events)-1].RelativeTime + time.Duration(expansion)*resolution
 
 	capacity := (max - min) / resolution
 
-	quantities := make(map[uint][]qot.QOT)
+	quantities := make(map[uint][]QOT)
 
-	for _, measure := range measures {
-		if _, exists := quantities[measure.Priority]; exists {
+	for _, event := range events {
+		if _, exists := quantities[event.Priority]; exists {
 			continue
 		}
 
-		quantities[measure.Priority] = make([]qot.QOT, 0, capacity)
+		quantities[event.Priority] = make([]QOT, 0, capacity)
 	}
 
-	measuresEdge := 0
+	eventsEdge := 0
 
 	for span := min + resolution; span <= max; span += resolution {
 		intervalQuantities := make(map[uint]uint)
 
-		for id, measure := range measures[measuresEdge:] {
-			if measure.RelativeTime >= span {
-				measuresEdge += id
+		for id, event := range events[eventsEdge:] {
+			if event.RelativeTime >= span {
+				eventsEdge += id
 				break
 			}
 
-			intervalQuantities[measure.Priority]++
+			intervalQuantities[event.Priority]++
 
 			// Prevent use of data from the last slice for spans
 			// greater than max relative time + resolution
-			if id == len(measures[measuresEdge:])-1 {
-				measuresEdge += id + 1
+			if id == len(events[eventsEdge:])-1 {
+				eventsEdge += id + 1
 			}
 		}
 
 		for priority, quantity := range intervalQuantities {
-			item := qot.QOT{
+			item := QOT{
 				Quantity:     quantity,
 				RelativeTime: span - resolution,
 			}
@@ -107,7 +111,7 @@ func CalcDataQuantity(
 				continue
 			}
 
-			item := qot.QOT{
+			item := QOT{
 				Quantity:     0,
 				RelativeTime: span - resolution,
 			}
@@ -119,15 +123,19 @@ func CalcDataQuantity(
 	return quantities
 }
 
+// Calculates the quantity of data elements per priority that are in processing
+// (received, but not completed) in each time span of the specified resolution.
+//
+// Events are sorted by relative time in place.
 func CalcInProcessing(
-	measures []measurer.Measure,
+	events []recorder.Event,
 	resolution time.Duration,
-) map[uint][]qot.QOT {
-	if len(measures) == 0 {
+) map[uint][]QOT {
+	if len(events) == 0 {
 		return nil
 	}
 
-	sortByRelativeTime(measures)
+	sortByRelativeTime(events)
 
 	// To see the initial zero values ​​on the graph
 	min := -resolution
@@ -136,21 +144,21 @@ func CalcInProcessing(
 	// And one more resolution added to max span value to see the final zero values ​on
 	// ​the graph
 	expansion := 2
-	max := measures[len(measures)-1].RelativeTime + time.Duration(expansion)*resolution
+	max := events[len(events)-1].RelativeTime + time.Duration(expansion)*resolution
 
 	capacity := (max - min) / resolution
 
-	quantities := make(map[uint][]qot.QOT)
+	quantities := make(map[uint][]QOT)
 
-	for _, measure := range measures {
-		if _, exists := quantities[measure.Priority]; exists {
+	for _, event := range events {
+		if _, exists := quantities[event.Priority]; exists {
 			continue
 		}
 
-		quantities[measure.Priority] = make([]qot.QOT, 0, capacity)
+		quantities[event.Priority] = make([]QOT, 0, capacity)
 	}
 
-	measuresEdge := 0
+	eventsEdge := 0
 
 	receivedQuantities := make(map[uint]map[uint]uint)
 
@@ -159,23 +167,27 @@ func CalcInProcessing(
 	}
 
 	for span := min + resolution; span <= max; span += resolution {
-		for id, measure := range measures[measuresEdge:] {
-			if measure.RelativeTime >= span {
-				measuresEdge += id
+		for id, event := range events[eventsEdge:] {
+			if event.RelativeTime >= span {
+				eventsEdge += id
 				break
 			}
 
-			switch measure.Kind {
-			case measurer.MeasureKindReceived:
-				receivedQuantities[measure.Priority][measure.Data]++
-			case measurer.MeasureKindCompleted:
-				receivedQuantities[measure.Priority][measure.Data]--
+			switch event.Kind {
+			case recorder.KindReceived:
+				receivedQuantities[event.Priority][event.Data]++
+			case recorder.KindCompleted:
+				// Completion of a data element whose receipt is not recorded, for
+				// example, due to the limited capacity of the recorder, is ignored
+				if receivedQuantities[event.Priority][event.Data] != 0 {
+					receivedQuantities[event.Priority][event.Data]--
+				}
 			}
 
 			// Prevent use of data from the last slice for spans
 			// greater than max relative time + resolution
-			if id == len(measures[measuresEdge:])-1 {
-				measuresEdge += id + 1
+			if id == len(events[eventsEdge:])-1 {
+				eventsEdge += id + 1
 			}
 		}
 
@@ -186,7 +198,7 @@ func CalcInProcessing(
 				quantity += amount
 			}
 
-			item := qot.QOT{
+			item := QOT{
 				Quantity:     quantity,
 				RelativeTime: span - resolution,
 			}
@@ -198,43 +210,49 @@ func CalcInProcessing(
 	return quantities
 }
 
+// Calculates the distribution of latencies between the recorder.KindProcessed and
+// the recorder.KindCompleted events per priority, that is, the time spent on
+// informing the discipline that the data element is no longer used. Latencies are
+// grouped into spans of the specified interval.
+//
+// Events are sorted by data in place.
 func CalcWriteToFeedbackLatency(
-	measures []measurer.Measure,
+	events []recorder.Event,
 	interval time.Duration,
-) map[uint][]qot.QOT {
-	if len(measures) == 0 {
+) map[uint][]QOT {
+	if len(events) == 0 {
 		return nil
 	}
 
-	sortByData(measures)
+	sortByData(events)
 
 	latencies := make(map[uint][]time.Duration)
-	pairs := make(map[uint]measurer.Measure)
+	pairs := make(map[uint]recorder.Event)
 
-	for _, measure := range measures {
-		switch measure.Kind {
-		case measurer.MeasureKindCompleted:
-			if _, exists := pairs[measure.Priority]; !exists {
-				pairs[measure.Priority] = measure
+	for _, event := range events {
+		switch event.Kind {
+		case recorder.KindCompleted:
+			if _, exists := pairs[event.Priority]; !exists {
+				pairs[event.Priority] = event
 				continue
 			}
 
-			latency := measure.RelativeTime - pairs[measure.Priority].RelativeTime
+			latency := event.RelativeTime - pairs[event.Priority].RelativeTime
 
-			latencies[measure.Priority] = append(latencies[measure.Priority], latency)
+			latencies[event.Priority] = append(latencies[event.Priority], latency)
 
-			delete(pairs, measure.Priority)
-		case measurer.MeasureKindProcessed:
-			if _, exists := pairs[measure.Priority]; !exists {
-				pairs[measure.Priority] = measure
+			delete(pairs, event.Priority)
+		case recorder.KindProcessed:
+			if _, exists := pairs[event.Priority]; !exists {
+				pairs[event.Priority] = event
 				continue
 			}
 
-			latency := pairs[measure.Priority].RelativeTime - measure.RelativeTime
+			latency := pairs[event.Priority].RelativeTime - event.RelativeTime
 
-			latencies[measure.Priority] = append(latencies[measure.Priority], latency)
+			latencies[event.Priority] = append(latencies[event.Priority], latency)
 
-			delete(pairs, measure.Priority)
+			delete(pairs, event.Priority)
 		}
 	}
 
@@ -244,7 +262,7 @@ func CalcWriteToFeedbackLatency(
 func processLatencies(
 	latencies map[uint][]time.Duration,
 	interval time.Duration,
-) map[uint][]qot.QOT {
+) map[uint][]QOT {
 	for priority := range latencies {
 		slices.Sort(latencies[priority])
 	}
@@ -269,10 +287,10 @@ func processLatencies(
 
 	capacity := (max - min) / interval
 
-	quantities := make(map[uint][]qot.QOT)
+	quantities := make(map[uint][]QOT)
 
 	for priority := range latencies {
-		quantities[priority] = make([]qot.QOT, 0, capacity)
+		quantities[priority] = make([]QOT, 0, capacity)
 	}
 
 	edges := make(map[uint]int)
@@ -298,7 +316,7 @@ func processLatencies(
 		}
 
 		for priority, quantity := range spanQuantities {
-			item := qot.QOT{
+			item := QOT{
 				Quantity:     quantity,
 				RelativeTime: span - interval,
 			}
@@ -311,7 +329,7 @@ func processLatencies(
 				continue
 			}
 
-			item := qot.QOT{
+			item := QOT{
 				Quantity:     0,
 				RelativeTime: span - interval,
 			}
@@ -323,8 +341,10 @@ func processLatencies(
 	return quantities
 }
 
+// Converts quantities over time to the line chart series, one per priority, and
+// the abscissa values expressed in the specified units of relative time.
 func ConvertToLineEcharts(
-	quantities map[uint][]qot.QOT,
+	quantities map[uint][]QOT,
 	relativeTimeUnit time.Duration,
 ) (map[uint][]chartsopts.LineData, []int) {
 	serieses := make(map[uint][]chartsopts.LineData)
@@ -356,8 +376,10 @@ func ConvertToLineEcharts(
 	return serieses, xaxis
 }
 
+// Converts quantities over time to the bar chart series, one per priority, and
+// the abscissa values equal to the span numbers.
 func ConvertToBarEcharts(
-	quantities map[uint][]qot.QOT,
+	quantities map[uint][]QOT,
 ) (map[uint][]chartsopts.BarData, []int) {
 	serieses := make(map[uint][]chartsopts.BarData)
 	xaxis := []int(nil)
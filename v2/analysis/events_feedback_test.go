@@ -1,197 +1,196 @@
-package research
+package analysis
 
 import (
 	"testing"
 	"time"
 
-	"github.com/akramarenkov/cqos/v2/internal/qot"
-	"github.com/akramarenkov/cqos/v2/priority/internal/measurer"
+	"github.com/akramarenkov/cqos/v2/recorder"
 
 	chartsopts "github.com/go-echarts/go-echarts/v2/opts"
 	"github.com/stretchr/testify/require"
 )
 
 func TestCalcWriteToFeedbackLatency(t *testing.T) {
-	measures := []measurer.Measure{
+	events := []recorder.Event{
 		// first priority
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 2 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 28 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 20,
 		},
 		{
 			Data:         3,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 35 * time.Microsecond,
 		},
 		{
 			Data:         3,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 30,
 		},
 		{
 			Data:         3,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 40 * time.Microsecond,
 		},
 		// third priority
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 4 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 3 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 5 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 3 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 3 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 7 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         3,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 3 * time.Microsecond,
 		},
 		{
 			Data:         3,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 8 * time.Microsecond,
 		},
 		{
 			Data:         3,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         4,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 3 * time.Microsecond,
 		},
 		{
 			Data:         4,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 9 * time.Microsecond,
 		},
 		{
 			Data:         4,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         5,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 3 * time.Microsecond,
 		},
 		{
 			Data:         5,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 19 * time.Microsecond,
 		},
 		{
 			Data:         5,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
@@ -199,7 +198,7 @@ func TestCalcWriteToFeedbackLatency(t *testing.T) {
 
 	interval := 5 * time.Microsecond
 
-	expected := map[uint][]qot.QOT{
+	expected := map[uint][]QOT{
 		1: {
 			{
 				RelativeTime: 0,
@@ -238,16 +237,16 @@ func TestCalcWriteToFeedbackLatency(t *testing.T) {
 		},
 	}
 
-	quantities := CalcWriteToFeedbackLatency(measures, interval)
+	quantities := CalcWriteToFeedbackLatency(events, interval)
 	require.Equal(t, expected, quantities)
 }
 
 func TestCalcWriteToFeedbackLatencyInput(t *testing.T) {
 	quantities := CalcWriteToFeedbackLatency(nil, 5*time.Microsecond)
-	require.Equal(t, map[uint][]qot.QOT(nil), quantities)
+	require.Equal(t, map[uint][]QOT(nil), quantities)
 
-	quantities = CalcWriteToFeedbackLatency([]measurer.Measure{}, 5*time.Microsecond)
-	require.Equal(t, map[uint][]qot.QOT(nil), quantities)
+	quantities = CalcWriteToFeedbackLatency([]recorder.Event{}, 5*time.Microsecond)
+	require.Equal(t, map[uint][]QOT(nil), quantities)
 }
 
 func TestProcessLatencies(t *testing.T) {
@@ -271,7 +270,7 @@ func TestProcessLatencies(t *testing.T) {
 
 	interval := 5 * time.Microsecond
 
-	expected := map[uint][]qot.QOT{
+	expected := map[uint][]QOT{
 		1: {
 			{
 				RelativeTime: 0,
@@ -335,7 +334,7 @@ func TestProcessLatencies(t *testing.T) {
 func TestConvertToBarEcharts(t *testing.T) {
 	resolution := 5 * time.Microsecond
 
-	quantities := map[uint][]qot.QOT{
+	quantities := map[uint][]QOT{
 		1: {
 			{
 				RelativeTime: -resolution,
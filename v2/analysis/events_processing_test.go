@@ -1,68 +1,67 @@
-package research
+package analysis
 
 import (
 	"testing"
 	"time"
 
-	"github.com/akramarenkov/cqos/v2/internal/qot"
-	"github.com/akramarenkov/cqos/v2/priority/internal/measurer"
+	"github.com/akramarenkov/cqos/v2/recorder"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestCalcInProcessing(t *testing.T) {
-	measures := []measurer.Measure{
+	events := []recorder.Event{
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     2,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     2,
 			RelativeTime: 20 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     2,
 			RelativeTime: time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 30 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 33 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
@@ -70,7 +69,7 @@ func TestCalcInProcessing(t *testing.T) {
 
 	resolution := 5 * time.Microsecond
 
-	expected := map[uint][]qot.QOT{
+	expected := map[uint][]QOT{
 		1: {
 			{
 				RelativeTime: -resolution,
@@ -187,14 +186,35 @@ func TestCalcInProcessing(t *testing.T) {
 		},
 	}
 
-	quantities := CalcInProcessing(measures, resolution)
+	quantities := CalcInProcessing(events, resolution)
+	require.Equal(t, expected, quantities)
+}
+
+func TestCalcInProcessingUnpaired(t *testing.T) {
+	resolution := 10 * time.Nanosecond
+
+	// Receipt of the first data element is not recorded
+	events := []recorder.Event{
+		{Data: 0, Kind: recorder.KindCompleted, Priority: 1, RelativeTime: 0},
+		{Data: 1, Kind: recorder.KindReceived, Priority: 1, RelativeTime: time.Nanosecond},
+	}
+
+	expected := map[uint][]QOT{
+		1: {
+			{Quantity: 0, RelativeTime: -resolution},
+			{Quantity: 1, RelativeTime: 0},
+			{Quantity: 1, RelativeTime: resolution},
+		},
+	}
+
+	quantities := CalcInProcessing(events, resolution)
 	require.Equal(t, expected, quantities)
 }
 
 func TestCalcInProcessingZeroInput(t *testing.T) {
 	quantities := CalcInProcessing(nil, 5*time.Microsecond)
-	require.Equal(t, map[uint][]qot.QOT(nil), quantities)
+	require.Equal(t, map[uint][]QOT(nil), quantities)
 
-	quantities = CalcInProcessing([]measurer.Measure{}, 5*time.Microsecond)
-	require.Equal(t, map[uint][]qot.QOT(nil), quantities)
+	quantities = CalcInProcessing([]recorder.Event{}, 5*time.Microsecond)
+	require.Equal(t, map[uint][]QOT(nil), quantities)
 }
@@ -1,69 +1,68 @@
-package research
+package analysis
 
 import (
 	"testing"
 	"time"
 
-	"github.com/akramarenkov/cqos/v2/internal/qot"
-	"github.com/akramarenkov/cqos/v2/priority/internal/measurer"
+	"github.com/akramarenkov/cqos/v2/recorder"
 
 	chartsopts "github.com/go-echarts/go-echarts/v2/opts"
 	"github.com/stretchr/testify/require"
 )
 
 func TestCalcDataQuantity(t *testing.T) {
-	measures := []measurer.Measure{
+	events := []recorder.Event{
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     2,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     2,
 			RelativeTime: 20 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     2,
 			RelativeTime: time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 30 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 33 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
@@ -71,7 +70,7 @@ func TestCalcDataQuantity(t *testing.T) {
 
 	resolution := 5 * time.Microsecond
 
-	expected := map[uint][]qot.QOT{
+	expected := map[uint][]QOT{
 		1: {
 			{
 				RelativeTime: -resolution,
@@ -188,22 +187,22 @@ func TestCalcDataQuantity(t *testing.T) {
 		},
 	}
 
-	quantities := CalcDataQuantity(measures, resolution)
+	quantities := CalcDataQuantity(events, resolution)
 	require.Equal(t, expected, quantities)
 }
 
 func TestCalcDataQuantityZeroInput(t *testing.T) {
 	quantities := CalcDataQuantity(nil, 5*time.Microsecond)
-	require.Equal(t, map[uint][]qot.QOT(nil), quantities)
+	require.Equal(t, map[uint][]QOT(nil), quantities)
 
-	quantities = CalcDataQuantity([]measurer.Measure{}, 5*time.Microsecond)
-	require.Equal(t, map[uint][]qot.QOT(nil), quantities)
+	quantities = CalcDataQuantity([]recorder.Event{}, 5*time.Microsecond)
+	require.Equal(t, map[uint][]QOT(nil), quantities)
 }
 
 func TestConvertToLineEcharts(t *testing.T) {
 	resolution := 5 * time.Microsecond
 
-	quantities := map[uint][]qot.QOT{
+	quantities := map[uint][]QOT{
 		1: {
 			{
 				RelativeTime: -resolution,
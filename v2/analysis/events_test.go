@@ -1,331 +1,331 @@
-package research
+package analysis
 
 import (
 	"testing"
 	"time"
 
-	"github.com/akramarenkov/cqos/v2/priority/internal/measurer"
+	"github.com/akramarenkov/cqos/v2/recorder"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestFilterByKind(t *testing.T) {
-	measures := []measurer.Measure{
+	events := []recorder.Event{
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     2,
 			RelativeTime: 20 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     2,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     2,
 			RelativeTime: time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 30 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 33 * time.Microsecond,
 		},
 	}
 
-	expected := []measurer.Measure{
+	expected := []recorder.Event{
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     2,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 33 * time.Microsecond,
 		},
 	}
 
-	filtered := FilterByKind(measures, measurer.MeasureKindCompleted)
+	filtered := FilterByKind(events, recorder.KindCompleted)
 	require.Equal(t, expected, filtered)
 }
 
 func TestSortByData(t *testing.T) {
-	measures := []measurer.Measure{
+	events := []recorder.Event{
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     2,
 			RelativeTime: 20 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     2,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 30 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     2,
 			RelativeTime: time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 33 * time.Microsecond,
 		},
 	}
 
-	expected := []measurer.Measure{
+	expected := []recorder.Event{
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     2,
 			RelativeTime: 20 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     2,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     2,
 			RelativeTime: time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 30 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 33 * time.Microsecond,
 		},
 	}
 
-	sortByData(measures)
-	require.Equal(t, expected, measures)
+	sortByData(events)
+	require.Equal(t, expected, events)
 }
 
 func TestSortByRelativeTime(t *testing.T) {
-	measures := []measurer.Measure{
+	events := []recorder.Event{
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     2,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     2,
 			RelativeTime: 20 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     2,
 			RelativeTime: time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 30 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 33 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 	}
 
-	expected := []measurer.Measure{
+	expected := []recorder.Event{
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     1,
 			RelativeTime: 0,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     3,
 			RelativeTime: 0,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindReceived,
+			Kind:         recorder.KindReceived,
 			Priority:     2,
 			RelativeTime: time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     1,
 			RelativeTime: 10 * time.Microsecond,
 		},
 		{
 			Data:         0,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     1,
 			RelativeTime: 11 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     2,
 			RelativeTime: 20 * time.Microsecond,
 		},
 		{
 			Data:         1,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     2,
 			RelativeTime: 25 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindProcessed,
+			Kind:         recorder.KindProcessed,
 			Priority:     3,
 			RelativeTime: 30 * time.Microsecond,
 		},
 		{
 			Data:         2,
-			Kind:         measurer.MeasureKindCompleted,
+			Kind:         recorder.KindCompleted,
 			Priority:     3,
 			RelativeTime: 33 * time.Microsecond,
 		},
 	}
 
-	sortByRelativeTime(measures)
-	require.Equal(t, expected, measures)
+	sortByRelativeTime(events)
+	require.Equal(t, expected, events)
 }
@@ -1,5 +1,4 @@
-// Internal package with research functions that are used for testing.
-package research
+package analysis
 
 import (
 	"math"
@@ -9,16 +8,34 @@ import (
 	"time"
 
 	"github.com/akramarenkov/cqos/v2/internal/consts"
-	"github.com/akramarenkov/cqos/v2/internal/qot"
+	"github.com/akramarenkov/cqos/v2/recorder"
 
 	chartsopts "github.com/go-echarts/go-echarts/v2/opts"
 )
 
+// Returns relative times of the events. Used to pass recorded events to
+// the functions that work with relative times.
+func RelativeTimes(events []recorder.Event) []time.Duration {
+	relativeTimes := make([]time.Duration, 0, len(events))
+
+	for _, event := range events {
+		relativeTimes = append(relativeTimes, event.RelativeTime)
+	}
+
+	return relativeTimes
+}
+
+// Calculates the quantity of relative times that fall into each interval.
+//
+// If the interval is zero, then it is calculated so that the relative times are
+// divided into the specified quantity of intervals. Returns the used interval.
+//
+// Relative times are sorted in place.
 func CalcIntervalQuantities(
 	relativeTimes []time.Duration,
 	intervalsQuantity int,
 	interval time.Duration,
-) ([]qot.QOT, time.Duration) {
+) ([]QOT, time.Duration) {
 	if len(relativeTimes) == 0 {
 		return nil, 0
 	}
@@ -51,7 +68,7 @@ func CalcIntervalQuantities(
 		intervalsQuantity = int(maxRelativeTime/interval) + 1
 	}
 
-	quantities := make([]qot.QOT, 0, intervalsQuantity)
+	quantities := make([]QOT, 0, intervalsQuantity)
 
 	edge := 0
 
@@ -75,7 +92,7 @@ func CalcIntervalQuantities(
 			}
 		}
 
-		item := qot.QOT{
+		item := QOT{
 			Quantity:     spanQuantities,
 			RelativeTime: span - interval,
 		}
@@ -86,7 +103,7 @@ func CalcIntervalQuantities(
 	// Padding with zero values ​​in case intervals quantity multiplied by
 	// interval is greater than max relative time
 	for addition := range intervalsQuantity - len(quantities) {
-		item := qot.QOT{
+		item := QOT{
 			Quantity:     0,
 			RelativeTime: maxRelativeTime + interval*time.Duration(addition+1),
 		}
@@ -97,8 +114,10 @@ func CalcIntervalQuantities(
 	return quantities, interval
 }
 
+// Converts quantities over time to the bar chart series and the abscissa values
+// equal to the interval numbers.
 func ConvertQuantityOverTimeToBarEcharts(
-	quantities []qot.QOT,
+	quantities []QOT,
 ) ([]chartsopts.BarData, []int) {
 	serieses := make([]chartsopts.BarData, 0, len(quantities))
 	xaxis := make([]int, 0, len(quantities))
@@ -119,6 +138,11 @@ func ConvertQuantityOverTimeToBarEcharts(
 	return serieses, xaxis
 }
 
+// Calculates the distribution of the deviations of the differences between
+// adjacent relative times from the expected value. Deviations are expressed as
+// a percentage and are limited to the range from -100% to 100%.
+//
+// Relative times are sorted in place.
 func CalcRelativeDeviations(
 	relativeTimes []time.Duration,
 	expected time.Duration,
@@ -171,6 +195,8 @@ func CalcRelativeDeviations(
 	return deviations
 }
 
+// Converts relative deviations to the bar chart series and the abscissa values
+// equal to the deviation percents.
 func ConvertRelativeDeviationsToBarEcharts(
 	deviations map[int]int,
 ) ([]chartsopts.BarData, []int) {
@@ -198,6 +224,7 @@ func ConvertRelativeDeviationsToBarEcharts(
 	return serieses, xaxis
 }
 
+// Returns the maximum of the durations.
 func CalcTotalDuration(durations []time.Duration) time.Duration {
 	if len(durations) == 0 {
 		return 0
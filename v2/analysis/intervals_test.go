@@ -1,14 +1,27 @@
-package research
+package analysis
 
 import (
 	"testing"
 	"time"
 
-	"github.com/akramarenkov/cqos/v2/internal/qot"
+	"github.com/akramarenkov/cqos/v2/recorder"
+
 	chartsopts "github.com/go-echarts/go-echarts/v2/opts"
 	"github.com/stretchr/testify/require"
 )
 
+func TestRelativeTimes(t *testing.T) {
+	events := []recorder.Event{
+		{RelativeTime: 2 * time.Second},
+		{RelativeTime: time.Second},
+	}
+
+	expected := []time.Duration{2 * time.Second, time.Second}
+
+	require.Equal(t, expected, RelativeTimes(events))
+	require.Equal(t, []time.Duration{}, RelativeTimes(nil))
+}
+
 func TestCalcIntervalQuantitiesSplitByInterval(t *testing.T) {
 	relativeTimes := []time.Duration{
 		0,
@@ -23,7 +36,7 @@ func TestCalcIntervalQuantitiesSplitByInterval(t *testing.T) {
 
 	interval := 10 * time.Millisecond
 
-	expected := []qot.QOT{
+	expected := []QOT{
 		{
 			Quantity:     5,
 			RelativeTime: 0,
@@ -83,7 +96,7 @@ func TestCalcIntervalQuantitiesSplitByIntervalEntirely(t *testing.T) {
 
 	interval := 10 * time.Millisecond
 
-	expected := []qot.QOT{
+	expected := []QOT{
 		{
 			Quantity:     5,
 			RelativeTime: 0,
@@ -123,7 +136,7 @@ func TestCalcIntervalQuantitiesSplitByIntervalsQuantity(t *testing.T) {
 
 	expectedCalcInterval := 8*time.Millisecond + 500*time.Microsecond + time.Nanosecond
 
-	expected := []qot.QOT{
+	expected := []QOT{
 		{
 			Quantity:     4,
 			RelativeTime: 0,
@@ -149,7 +162,7 @@ func TestCalcIntervalQuantitiesZeroInput(t *testing.T) {
 		0,
 		time.Second,
 	)
-	require.Equal(t, []qot.QOT(nil), quantities)
+	require.Equal(t, []QOT(nil), quantities)
 	require.Equal(t, time.Duration(0), calcInterval)
 
 	quantities, calcInterval = CalcIntervalQuantities(
@@ -157,7 +170,7 @@ func TestCalcIntervalQuantitiesZeroInput(t *testing.T) {
 		0,
 		time.Second,
 	)
-	require.Equal(t, []qot.QOT(nil), quantities)
+	require.Equal(t, []QOT(nil), quantities)
 	require.Equal(t, time.Duration(0), calcInterval)
 }
 
@@ -167,7 +180,7 @@ func TestCalcIntervalQuantitiesZeroSplit(t *testing.T) {
 		0,
 		0,
 	)
-	require.Equal(t, []qot.QOT(nil), quantities)
+	require.Equal(t, []QOT(nil), quantities)
 	require.Equal(t, time.Duration(0), calcInterval)
 }
 
@@ -183,7 +196,7 @@ func TestCalcIntervalQuantitiesSmallRatio(t *testing.T) {
 
 	expectedCalcInterval := time.Nanosecond
 
-	expected := []qot.QOT{
+	expected := []QOT{
 		{
 			Quantity:     1,
 			RelativeTime: 0,
@@ -1,5 +1,4 @@
-// Internal package describing the quantity over time structure.
-package qot
+package analysis
 
 import (
 	"time"
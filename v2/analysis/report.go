@@ -0,0 +1,271 @@
+package analysis
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/recorder"
+
+	"github.com/go-echarts/go-echarts/v2/charts"
+	"github.com/go-echarts/go-echarts/v2/components"
+	chartsopts "github.com/go-echarts/go-echarts/v2/opts"
+)
+
+const (
+	// Default value of Resolution option if it is not specified.
+	DefaultResolution = 100 * time.Millisecond
+	// Default value of RelativeTimeUnit option if it is not specified.
+	DefaultRelativeTimeUnit = time.Millisecond
+	// Default value of LatencyInterval option if it is not specified.
+	DefaultLatencyInterval = time.Microsecond
+	// Default value of Title option if it is not specified.
+	DefaultTitle = "Discipline report"
+)
+
+var (
+	ErrAssetMissing = errors.New("script is not embedded into the package")
+)
+
+const (
+	assetsDir   = "assets"
+	decimalBase = 10
+)
+
+//go:generate curl -fsSL -o assets/echarts.min.js https://cdn.jsdelivr.net/npm/echarts@5.1.2/dist/echarts.min.js
+
+// Scripts inlined into the report.
+//
+//go:embed assets
+var assets embed.FS
+
+// Options of the created report.
+type ReportOpts struct {
+	// Host from which the echarts scripts are loaded. An empty value means that
+	// the scripts embedded into the package are inlined into the report, so it can
+	// be viewed without access to the Internet
+	AssetsHost string
+	// Interval by which the write to feedback latencies are grouped
+	LatencyInterval time.Duration
+	// Units of relative time on the abscissa of the charts over time
+	RelativeTimeUnit time.Duration
+	// Duration of the time span in which events are counted on the charts over time
+	Resolution time.Duration
+	// Subtitle of the charts, for example, a description of the discipline
+	// configuration
+	Subtitle string
+	// Title of the report page
+	Title string
+}
+
+func (opts ReportOpts) normalize() ReportOpts {
+	if opts.LatencyInterval <= 0 {
+		opts.LatencyInterval = DefaultLatencyInterval
+	}
+
+	if opts.RelativeTimeUnit <= 0 {
+		opts.RelativeTimeUnit = DefaultRelativeTimeUnit
+	}
+
+	if opts.Resolution <= 0 {
+		opts.Resolution = DefaultResolution
+	}
+
+	if opts.Title == "" {
+		opts.Title = DefaultTitle
+	}
+
+	return opts
+}
+
+// Writes to the writer an HTML page with the charts built from the recorded events:
+//
+//   - data retrieval - quantity of the recorder.KindReceived events over time
+//   - in processing - quantity of the data elements that are in processing over time
+//   - write to feedback latency - distribution of the latencies of the Release()
+//     method calls
+//
+// Charts for which there are no suitable events are omitted. All charts are placed
+// on a single page. By default, the echarts scripts are inlined into the page, so
+// it is self-contained.
+//
+// Passed events are not modified.
+func WriteReport(writer io.Writer, events []recorder.Event, opts ReportOpts) error {
+	opts = opts.normalize()
+
+	page := components.NewPage()
+
+	page.PageTitle = opts.Title
+
+	if opts.AssetsHost != "" {
+		page.AssetsHost = opts.AssetsHost
+	}
+
+	page.AddCharts(createReportCharts(events, opts)...)
+
+	if opts.AssetsHost != "" {
+		return page.Render(writer)
+	}
+
+	buffer := &bytes.Buffer{}
+
+	if err := page.Render(buffer); err != nil {
+		return err
+	}
+
+	// Host prefixes are added to the scripts addresses during rendering
+	inlined, err := inlineScripts(buffer.Bytes(), page.JSAssets.Values, assets)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(inlined)
+
+	return err
+}
+
+// Replaces links to the scripts with the contents of the files with the same names
+// from the assets directory of the file system. All the scripts must be present
+// in the file system, otherwise the page would not be self-contained.
+func inlineScripts(page []byte, addresses []string, fsys fs.FS) ([]byte, error) {
+	for _, address := range addresses {
+		name := path.Base(address)
+
+		script, err := fs.ReadFile(fsys, path.Join(assetsDir, name))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrAssetMissing, name)
+		}
+
+		// Script is not allowed to close the element in which it is inlined
+		script = bytes.ReplaceAll(script, []byte("</script"), []byte(`<\/script`))
+
+		link := []byte(`<script src="` + address + `"></script>`)
+		inlined := slices.Concat([]byte("<script>"), script, []byte("</script>"))
+
+		page = bytes.ReplaceAll(page, link, inlined)
+	}
+
+	return page, nil
+}
+
+func createReportCharts(events []recorder.Event, opts ReportOpts) []components.Charter {
+	events = slices.Clone(events)
+
+	out := make([]components.Charter, 0)
+
+	received := FilterByKind(events, recorder.KindReceived)
+
+	if len(received) != 0 {
+		serieses, abscissa := ConvertToLineEcharts(
+			CalcDataQuantity(received, opts.Resolution),
+			opts.RelativeTimeUnit,
+		)
+
+		out = append(out, createLineChart("Data retrieval", serieses, abscissa, opts))
+	}
+
+	if len(FilterByKind(events, recorder.KindCompleted)) != 0 {
+		serieses, abscissa := ConvertToLineEcharts(
+			CalcInProcessing(events, opts.Resolution),
+			opts.RelativeTimeUnit,
+		)
+
+		out = append(out, createLineChart("In processing", serieses, abscissa, opts))
+	}
+
+	if len(FilterByKind(events, recorder.KindProcessed)) != 0 {
+		serieses, abscissa := ConvertToBarEcharts(
+			CalcWriteToFeedbackLatency(events, opts.LatencyInterval),
+		)
+
+		out = append(
+			out,
+			createBarChart("Write to feedback latency", serieses, abscissa, opts),
+		)
+	}
+
+	return out
+}
+
+func createLineChart(
+	title string,
+	serieses map[uint][]chartsopts.LineData,
+	abscissa []int,
+	opts ReportOpts,
+) *charts.Line {
+	chart := charts.NewLine()
+
+	chart.SetGlobalOptions(
+		charts.WithInitializationOpts(
+			chartsopts.Initialization{
+				AssetsHost: opts.AssetsHost,
+			},
+		),
+		charts.WithTitleOpts(
+			chartsopts.Title{
+				Title:    title,
+				Subtitle: opts.Subtitle,
+			},
+		),
+	)
+
+	chart.SetXAxis(abscissa)
+
+	for _, priority := range sortSeriesesPriorities(serieses) {
+		chart.AddSeries(strconv.FormatUint(uint64(priority), decimalBase), serieses[priority])
+	}
+
+	return chart
+}
+
+func createBarChart(
+	title string,
+	serieses map[uint][]chartsopts.BarData,
+	abscissa []int,
+	opts ReportOpts,
+) *charts.Bar {
+	chart := charts.NewBar()
+
+	chart.SetGlobalOptions(
+		charts.WithInitializationOpts(
+			chartsopts.Initialization{
+				AssetsHost: opts.AssetsHost,
+			},
+		),
+		charts.WithTitleOpts(
+			chartsopts.Title{
+				Title:    title,
+				Subtitle: opts.Subtitle,
+			},
+		),
+	)
+
+	chart.SetXAxis(abscissa)
+
+	for _, priority := range sortSeriesesPriorities(serieses) {
+		chart.AddSeries(strconv.FormatUint(uint64(priority), decimalBase), serieses[priority])
+	}
+
+	return chart
+}
+
+// Priorities are sorted from highest to lowest, as in the prioritization discipline.
+func sortSeriesesPriorities[Type any](serieses map[uint]Type) []uint {
+	priorities := make([]uint, 0, len(serieses))
+
+	for priority := range serieses {
+		priorities = append(priorities, priority)
+	}
+
+	slices.Sort(priorities)
+	slices.Reverse(priorities)
+
+	return priorities
+}
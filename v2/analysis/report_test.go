@@ -0,0 +1,138 @@
+package analysis
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/recorder"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReport(t *testing.T) {
+	events := []recorder.Event{
+		{
+			Data:         1,
+			Kind:         recorder.KindReceived,
+			Priority:     1,
+			RelativeTime: 3 * time.Millisecond,
+		},
+		{
+			Data:         0,
+			Kind:         recorder.KindReceived,
+			Priority:     2,
+			RelativeTime: time.Millisecond,
+		},
+		{
+			Data:         0,
+			Kind:         recorder.KindProcessed,
+			Priority:     2,
+			RelativeTime: 2 * time.Millisecond,
+		},
+		{
+			Data:         0,
+			Kind:         recorder.KindCompleted,
+			Priority:     2,
+			RelativeTime: 2*time.Millisecond + time.Microsecond,
+		},
+	}
+
+	original := slices.Clone(events)
+
+	buffer := &bytes.Buffer{}
+
+	opts := ReportOpts{
+		AssetsHost: "http://localhost/assets/",
+		Subtitle:   "Test subtitle",
+		Title:      "Test report",
+	}
+
+	err := WriteReport(buffer, events, opts)
+	require.NoError(t, err)
+	require.Equal(t, original, events)
+
+	report := buffer.String()
+
+	require.Contains(t, report, "<title>Test report</title>")
+	require.Contains(t, report, "Test subtitle")
+	require.Contains(t, report, "Data retrieval")
+	require.Contains(t, report, "In processing")
+	require.Contains(t, report, "Write to feedback latency")
+	require.Contains(t, report, "http://localhost/assets/")
+}
+
+func TestInlineScripts(t *testing.T) {
+	fsys := fstest.MapFS{
+		"assets/first.js":  &fstest.MapFile{Data: []byte(`var first = "</script>";`)},
+		"assets/second.js": &fstest.MapFile{Data: []byte(`var second;`)},
+	}
+
+	page := []byte(
+		`<script src="http://localhost/first.js"></script>` +
+			`<script src="http://localhost/second.js"></script>`,
+	)
+
+	addresses := []string{"http://localhost/first.js", "http://localhost/second.js"}
+
+	expected := `<script>var first = "<\/script>";</script><script>var second;</script>`
+
+	inlined, err := inlineScripts(page, addresses, fsys)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(inlined))
+
+	delete(fsys, "assets/second.js")
+
+	_, err = inlineScripts(page, addresses, fsys)
+	require.ErrorIs(t, err, ErrAssetMissing)
+}
+
+func TestWriteReportSelfContained(t *testing.T) {
+	events := []recorder.Event{
+		{
+			Kind:         recorder.KindReceived,
+			RelativeTime: time.Millisecond,
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+
+	err := WriteReport(buffer, events, ReportOpts{})
+	require.NoError(t, err)
+
+	report := buffer.String()
+
+	require.NotContains(t, report, "<script src=")
+	require.Contains(t, report, "echarts.init(")
+}
+
+func TestWriteReportReceivedOnly(t *testing.T) {
+	events := []recorder.Event{
+		{
+			Kind:         recorder.KindReceived,
+			RelativeTime: time.Millisecond,
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+
+	err := WriteReport(buffer, events, ReportOpts{})
+	require.NoError(t, err)
+
+	report := buffer.String()
+
+	require.Contains(t, report, "<title>"+DefaultTitle+"</title>")
+	require.Contains(t, report, "Data retrieval")
+	require.NotContains(t, report, "In processing")
+	require.NotContains(t, report, "Write to feedback latency")
+}
+
+func TestWriteReportZeroInput(t *testing.T) {
+	buffer := &bytes.Buffer{}
+
+	err := WriteReport(buffer, nil, ReportOpts{})
+	require.NoError(t, err)
+	require.NotContains(t, buffer.String(), "Data retrieval")
+}
@@ -0,0 +1,70 @@
+// Package with the time source used by the disciplines. It allows you to replace
+// the wall clock with a manually controlled clock for deterministic testing.
+package clock
+
+import (
+	"time"
+)
+
+// Source of the current time, timers and delays.
+type Clock interface {
+	// Returns the current time
+	Now() time.Time
+	// Creates a new timer that sends the current time to its channel after at
+	// least the specified duration
+	NewTimer(duration time.Duration) Timer
+	// Returns the time elapsed since the specified time
+	Since(since time.Time) time.Duration
+	// Pauses the current goroutine for at least the specified duration
+	Sleep(duration time.Duration)
+}
+
+// Timer created by the Clock.
+//
+// Follows the semantics of the timers of the time package since Go 1.23: after
+// the Reset() or Stop() methods return, no stale values will be received from
+// the channel.
+type Timer interface {
+	// Returns the channel on which the expiration time is delivered
+	C() <-chan time.Time
+	// Changes the timer to expire after the specified duration. Returns true if
+	// the timer had been active
+	Reset(duration time.Duration) bool
+	// Prevents the timer from firing. Returns true if the timer had been active
+	Stop() bool
+}
+
+// Clock that uses the functions of the time package.
+type Real struct{}
+
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+func (Real) NewTimer(duration time.Duration) Timer {
+	return realTimer{timer: time.NewTimer(duration)}
+}
+
+func (Real) Since(since time.Time) time.Duration {
+	return time.Since(since)
+}
+
+func (Real) Sleep(duration time.Duration) {
+	time.Sleep(duration)
+}
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (tmr realTimer) C() <-chan time.Time {
+	return tmr.timer.C
+}
+
+func (tmr realTimer) Reset(duration time.Duration) bool {
+	return tmr.timer.Reset(duration)
+}
+
+func (tmr realTimer) Stop() bool {
+	return tmr.timer.Stop()
+}
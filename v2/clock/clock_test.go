@@ -0,0 +1,27 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReal(t *testing.T) {
+	clock := Real{}
+
+	startedAt := clock.Now()
+
+	clock.Sleep(time.Millisecond)
+
+	require.GreaterOrEqual(t, clock.Since(startedAt), time.Millisecond)
+
+	timer := clock.NewTimer(time.Millisecond)
+
+	fired := <-timer.C()
+	require.False(t, fired.Before(startedAt))
+	require.False(t, timer.Stop())
+
+	require.False(t, timer.Reset(time.Hour))
+	require.True(t, timer.Stop())
+}
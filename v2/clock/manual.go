@@ -0,0 +1,209 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+const timerCapacity = 1
+
+// Clock whose time changes only when the Advance() or Set() methods are called.
+//
+// Sleeps and timers created by the clock are blocked until the time is
+// advanced enough. To avoid races between advancing the time and, for example,
+// creating a timer by the discipline under test, use the BlockUntil() method.
+type Manual struct {
+	cond *sync.Cond
+	now  time.Time
+
+	sleepers map[*manualSleeper]struct{}
+	timers   map[*manualTimer]struct{}
+}
+
+type manualSleeper struct {
+	deadline time.Time
+	wakeup   chan struct{}
+}
+
+type manualTimer struct {
+	clock *Manual
+
+	channel  chan time.Time
+	deadline time.Time
+}
+
+// Creates manual clock with the specified initial time.
+func NewManual(now time.Time) *Manual {
+	mnl := &Manual{
+		cond: sync.NewCond(&sync.Mutex{}),
+		now:  now,
+
+		sleepers: make(map[*manualSleeper]struct{}),
+		timers:   make(map[*manualTimer]struct{}),
+	}
+
+	return mnl
+}
+
+func (mnl *Manual) Now() time.Time {
+	mnl.cond.L.Lock()
+	defer mnl.cond.L.Unlock()
+
+	return mnl.now
+}
+
+func (mnl *Manual) Since(since time.Time) time.Duration {
+	return mnl.Now().Sub(since)
+}
+
+func (mnl *Manual) Sleep(duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+
+	mnl.cond.L.Lock()
+
+	sleeper := &manualSleeper{
+		deadline: mnl.now.Add(duration),
+		wakeup:   make(chan struct{}),
+	}
+
+	mnl.sleepers[sleeper] = struct{}{}
+	mnl.cond.Broadcast()
+
+	mnl.cond.L.Unlock()
+
+	<-sleeper.wakeup
+}
+
+func (mnl *Manual) NewTimer(duration time.Duration) Timer {
+	mnl.cond.L.Lock()
+	defer mnl.cond.L.Unlock()
+
+	timer := &manualTimer{
+		clock: mnl,
+
+		channel: make(chan time.Time, timerCapacity),
+	}
+
+	timer.start(duration)
+
+	return timer
+}
+
+// Advances the time by the specified duration. Wakes up sleeps and fires timers
+// whose time has come.
+func (mnl *Manual) Advance(duration time.Duration) {
+	mnl.cond.L.Lock()
+	defer mnl.cond.L.Unlock()
+
+	mnl.set(mnl.now.Add(duration))
+}
+
+// Sets the time to the specified value. Setting a time earlier than the current
+// one does not wake up sleeps and does not fire timers.
+func (mnl *Manual) Set(now time.Time) {
+	mnl.cond.L.Lock()
+	defer mnl.cond.L.Unlock()
+
+	mnl.set(now)
+}
+
+func (mnl *Manual) set(now time.Time) {
+	mnl.now = now
+
+	for sleeper := range mnl.sleepers {
+		if sleeper.deadline.After(mnl.now) {
+			continue
+		}
+
+		close(sleeper.wakeup)
+		delete(mnl.sleepers, sleeper)
+	}
+
+	for timer := range mnl.timers {
+		timer.fire(mnl.now)
+	}
+}
+
+// Blocks until the total quantity of sleeping goroutines and active timers
+// becomes at least the specified value.
+func (mnl *Manual) BlockUntil(quantity int) {
+	mnl.cond.L.Lock()
+	defer mnl.cond.L.Unlock()
+
+	for mnl.blockers() < quantity {
+		mnl.cond.Wait()
+	}
+}
+
+// Returns the total quantity of sleeping goroutines and active timers.
+func (mnl *Manual) Blockers() int {
+	mnl.cond.L.Lock()
+	defer mnl.cond.L.Unlock()
+
+	return mnl.blockers()
+}
+
+func (mnl *Manual) blockers() int {
+	return len(mnl.sleepers) + len(mnl.timers)
+}
+
+func (tmr *manualTimer) C() <-chan time.Time {
+	return tmr.channel
+}
+
+func (tmr *manualTimer) Reset(duration time.Duration) bool {
+	tmr.clock.cond.L.Lock()
+	defer tmr.clock.cond.L.Unlock()
+
+	active := tmr.stop()
+
+	tmr.start(duration)
+
+	return active
+}
+
+func (tmr *manualTimer) Stop() bool {
+	tmr.clock.cond.L.Lock()
+	defer tmr.clock.cond.L.Unlock()
+
+	return tmr.stop()
+}
+
+func (tmr *manualTimer) start(duration time.Duration) {
+	tmr.deadline = tmr.clock.now.Add(duration)
+
+	tmr.clock.timers[tmr] = struct{}{}
+	tmr.clock.cond.Broadcast()
+
+	tmr.fire(tmr.clock.now)
+}
+
+func (tmr *manualTimer) stop() bool {
+	_, active := tmr.clock.timers[tmr]
+
+	delete(tmr.clock.timers, tmr)
+
+	// As with the timers of the time package since Go 1.23, a stale value is
+	// not received after stopping
+	select {
+	case <-tmr.channel:
+	default:
+	}
+
+	return active
+}
+
+func (tmr *manualTimer) fire(now time.Time) {
+	if tmr.deadline.After(now) {
+		return
+	}
+
+	delete(tmr.clock.timers, tmr)
+
+	select {
+	case tmr.channel <- now:
+	default:
+	}
+}
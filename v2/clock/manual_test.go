@@ -0,0 +1,110 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestManualNow(t *testing.T) {
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	clock := NewManual(startedAt)
+	require.Equal(t, startedAt, clock.Now())
+	require.Equal(t, time.Duration(0), clock.Since(startedAt))
+
+	clock.Advance(time.Second)
+	require.Equal(t, startedAt.Add(time.Second), clock.Now())
+	require.Equal(t, time.Second, clock.Since(startedAt))
+
+	clock.Set(startedAt.Add(time.Hour))
+	require.Equal(t, time.Hour, clock.Since(startedAt))
+}
+
+func TestManualSleep(t *testing.T) {
+	clock := NewManual(time.Time{})
+
+	clock.Sleep(0)
+	clock.Sleep(-time.Second)
+
+	awakened := make(chan struct{})
+
+	go func() {
+		defer close(awakened)
+
+		clock.Sleep(time.Second)
+	}()
+
+	clock.BlockUntil(1)
+	require.Equal(t, 1, clock.Blockers())
+
+	clock.Advance(time.Second - 1)
+
+	select {
+	case <-awakened:
+		require.FailNow(t, "sleep ended before the time was advanced enough")
+	default:
+	}
+
+	require.Equal(t, 1, clock.Blockers())
+
+	clock.Advance(1)
+
+	<-awakened
+
+	require.Equal(t, 0, clock.Blockers())
+}
+
+func TestManualTimer(t *testing.T) {
+	startedAt := time.Time{}
+
+	clock := NewManual(startedAt)
+
+	timer := clock.NewTimer(time.Second)
+	require.Equal(t, 1, clock.Blockers())
+
+	clock.Advance(time.Second / 2)
+	require.Empty(t, timer.C())
+
+	clock.Advance(time.Second / 2)
+	require.Equal(t, startedAt.Add(time.Second), <-timer.C())
+	require.Equal(t, 0, clock.Blockers())
+
+	clock.Advance(time.Second)
+	require.Empty(t, timer.C())
+
+	require.False(t, timer.Reset(time.Second))
+	require.Equal(t, 1, clock.Blockers())
+
+	// Stale value is not received after reset
+	clock.Advance(time.Second)
+	require.False(t, timer.Reset(time.Second))
+	require.Empty(t, timer.C())
+
+	clock.Advance(time.Second)
+	require.Equal(t, startedAt.Add(4*time.Second), <-timer.C())
+
+	require.False(t, timer.Reset(time.Second))
+	require.True(t, timer.Stop())
+	require.Equal(t, 0, clock.Blockers())
+
+	clock.Advance(time.Second)
+	require.Empty(t, timer.C())
+
+	require.False(t, timer.Reset(0))
+	require.Equal(t, startedAt.Add(5*time.Second), <-timer.C())
+}
+
+func TestManualSetBackward(t *testing.T) {
+	startedAt := time.Time{}.Add(time.Hour)
+
+	clock := NewManual(startedAt)
+
+	timer := clock.NewTimer(time.Second)
+	defer timer.Stop()
+
+	clock.Set(startedAt.Add(-time.Minute))
+	require.Empty(t, timer.C())
+	require.Equal(t, -time.Minute, clock.Since(startedAt))
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+	"github.com/akramarenkov/cqos/v2/priority/utils"
+)
+
+var (
+	ErrDividerUnknown      = errors.New("dividing function is unknown")
+	ErrFormatUnknown       = errors.New("output format is unknown")
+	ErrMethodUnknown       = errors.New("method of selection of combinations is unknown")
+	ErrPrioritiesDuplicate = errors.New("priorities are duplicated")
+	ErrPrioritiesEmpty     = errors.New("priorities was not specified")
+	ErrRangeInvalid        = errors.New("minimum quantity of handlers is greater than maximum")
+	ErrRangeZero           = errors.New("minimum quantity of handlers is zero")
+	ErrToleranceNegative   = errors.New("tolerance is negative")
+	ErrTooManyPriorities   = errors.New(
+		"too many priorities for the exhaustive method, use the fair or sampling method",
+	)
+)
+
+const (
+	formatJSON = "json"
+	formatText = "text"
+)
+
+const (
+	defaultMaxQuantity = 100
+	defaultMinQuantity = 1
+	defaultTolerance   = 10
+)
+
+// The exhaustive method checks 2^n - 1 combinations of priorities and all of them are
+// kept in memory during the analysis, so it is only suitable for small n.
+const maxExhaustivePriorities = 16
+
+type config struct {
+	Divider     divider.Divider
+	DividerName string
+	Format      string
+	MaxQuantity uint
+	Method      utils.Method
+	MethodName  string
+	MinQuantity uint
+	Priorities  []uint
+	Quantity    uint
+	Tolerance   float64
+	Worst       int
+}
+
+func dividers() map[string]divider.Divider {
+	return map[string]divider.Divider{
+		"fair": divider.Fair,
+		"rate": divider.Rate,
+	}
+}
+
+func methods() map[string]utils.Method {
+	return map[string]utils.Method{
+		"exhaustive": utils.MethodExhaustive,
+		"fair":       utils.MethodFair,
+		"sampling":   utils.MethodSampling,
+	}
+}
+
+func parseConfig(args []string, errput io.Writer) (config, error) {
+	cfg := config{}
+
+	priorities := ""
+
+	flags := flag.NewFlagSet("planner", flag.ContinueOnError)
+	flags.SetOutput(errput)
+
+	flags.StringVar(&priorities, "priorities", "", "comma-separated list of priorities")
+	flags.StringVar(&cfg.DividerName, "divider", "fair", "dividing function: fair or rate")
+	flags.StringVar(
+		&cfg.MethodName,
+		"method",
+		"exhaustive",
+		"method of selection of combinations: exhaustive, fair or sampling",
+	)
+	flags.UintVar(&cfg.MinQuantity, "min", defaultMinQuantity, "minimum quantity of handlers")
+	flags.UintVar(&cfg.MaxQuantity, "max", defaultMaxQuantity, "maximum quantity of handlers")
+	flags.Float64Var(
+		&cfg.Tolerance,
+		"tolerance",
+		defaultTolerance,
+		"limit of the distribution error, in percents",
+	)
+	flags.UintVar(
+		&cfg.Quantity,
+		"quantity",
+		0,
+		"quantity of handlers for the distribution table",
+	)
+	flags.IntVar(
+		&cfg.Worst,
+		"worst",
+		0,
+		"show only the specified quantity of combinations with the largest errors",
+	)
+	flags.StringVar(&cfg.Format, "format", formatText, "output format: text or json")
+
+	if err := flags.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	parsed, err := parsePriorities(priorities)
+	if err != nil {
+		return config{}, err
+	}
+
+	cfg.Priorities = parsed
+
+	return cfg, cfg.complete()
+}
+
+func (cfg *config) complete() error {
+	divider, exists := dividers()[cfg.DividerName]
+	if !exists {
+		return ErrDividerUnknown
+	}
+
+	method, exists := methods()[cfg.MethodName]
+	if !exists {
+		return ErrMethodUnknown
+	}
+
+	if method == utils.MethodExhaustive && len(cfg.Priorities) > maxExhaustivePriorities {
+		return ErrTooManyPriorities
+	}
+
+	if cfg.Format != formatText && cfg.Format != formatJSON {
+		return ErrFormatUnknown
+	}
+
+	if cfg.MinQuantity == 0 {
+		return ErrRangeZero
+	}
+
+	if cfg.MinQuantity > cfg.MaxQuantity {
+		return ErrRangeInvalid
+	}
+
+	if cfg.Tolerance < 0 {
+		return ErrToleranceNegative
+	}
+
+	cfg.Divider = divider
+	cfg.Method = method
+
+	return nil
+}
+
+func parsePriorities(value string) ([]uint, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, ErrPrioritiesEmpty
+	}
+
+	fields := strings.Split(value, ",")
+	priorities := make([]uint, 0, len(fields))
+
+	for _, field := range fields {
+		priority, err := strconv.ParseUint(strings.TrimSpace(field), 10, strconv.IntSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.Contains(priorities, uint(priority)) {
+			return nil, ErrPrioritiesDuplicate
+		}
+
+		priorities = append(priorities, uint(priority))
+	}
+
+	return priorities, nil
+}
@@ -0,0 +1,58 @@
+// Command planner checks configurations of the prioritization discipline: picks up
+// the minimum and maximum quantities of handlers for which the distribution error
+// is non-fatal and does not exceed the tolerance, and prints the distribution of
+// handlers for each combination of priorities checked by the selected method.
+//
+// Usage:
+//
+//	planner -priorities 3,2,1 -divider rate -min 1 -max 100 -tolerance 10
+//
+// Flags:
+//
+//	-priorities  comma-separated list of priorities
+//	-divider     dividing function: fair or rate (default fair)
+//	-method      method of selection of combinations: exhaustive, fair or sampling
+//	             (default exhaustive), fair method requires fair dividing function,
+//	             exhaustive method is limited to 16 priorities
+//	-min         minimum quantity of handlers (default 1)
+//	-max         maximum quantity of handlers (default 100)
+//	-tolerance   limit of the distribution error, in percents (default 10)
+//	-quantity    quantity of handlers for the distribution table, by default the
+//	             minimum suitable quantity or, if it is not found, the maximum
+//	             quantity of handlers
+//	-worst       show only the specified quantity of combinations with the largest
+//	             errors in the distribution table, zero means all (default 0)
+//	-format      output format: text or json (default text)
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+		}
+
+		os.Exit(1)
+	}
+}
+
+func run(args []string, output io.Writer, errput io.Writer) error {
+	cfg, err := parseConfig(args, errput)
+	if err != nil {
+		return err
+	}
+
+	plan, err := createPlan(cfg)
+	if err != nil {
+		return err
+	}
+
+	return writePlan(output, cfg, plan)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunText(t *testing.T) {
+	args := []string{
+		"-priorities", "2,1",
+		"-divider", "rate",
+		"-min", "1",
+		"-max", "10",
+		"-tolerance", "0",
+	}
+
+	expected := `Priorities: [2 1]
+Divider: rate
+Method: exhaustive
+Range of quantity of handlers: 1-10
+Tolerance: 0%
+Min non-fatal quantity: 2
+Max non-fatal quantity: 10
+Min suitable quantity: 3
+Max suitable quantity: 9
+
+Distribution for 3 handlers (3 of 3 combinations):
+combination  priority  ideal  actual  absolute error  relative error, %
+[2]          2         3.000  3       0.000           0.00
+[1]          1         3.000  3       0.000           0.00
+[2 1]        2         2.000  2       0.000           0.00
+[2 1]        1         1.000  1       0.000           0.00
+`
+
+	output := &bytes.Buffer{}
+
+	err := run(args, output, io.Discard)
+	require.NoError(t, err)
+	require.Equal(t, expected, output.String())
+}
+
+func TestRunJSON(t *testing.T) {
+	args := []string{
+		"-priorities", "3, 2, 1",
+		"-max", "2",
+		"-quantity", "2",
+		"-worst", "1",
+		"-format", "json",
+	}
+
+	output := &bytes.Buffer{}
+
+	err := run(args, output, io.Discard)
+	require.NoError(t, err)
+
+	decoded := jsonPlan{}
+
+	err = json.Unmarshal(output.Bytes(), &decoded)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint{3, 2, 1}, decoded.Priorities)
+	require.Equal(t, "fair", decoded.Divider)
+	require.Equal(t, uint(0), decoded.MinNonFatal)
+	require.Equal(t, uint(0), decoded.MaxNonFatal)
+	require.Equal(t, uint(0), decoded.MinSuitable)
+	require.Equal(t, uint(0), decoded.MaxSuitable)
+	require.Equal(t, uint(2), decoded.Quantity)
+	require.Equal(t, 7, decoded.TotalCombinations)
+	require.Len(t, decoded.Combinations, 1)
+	require.True(t, decoded.Combinations[0].Fatal)
+	require.Equal(t, []uint{3, 2, 1}, decoded.Combinations[0].Priorities)
+}
+
+func TestRunManyPriorities(t *testing.T) {
+	args := []string{
+		"-priorities", "24,23,22,21,20,19,18,17,16,15,14,13,12,11,10,9,8,7,6,5,4,3,2,1",
+		"-divider", "rate",
+		"-method", "sampling",
+		"-max", "40",
+		"-worst", "5",
+		"-format", "json",
+	}
+
+	output := &bytes.Buffer{}
+
+	err := run(args, output, io.Discard)
+	require.NoError(t, err)
+
+	decoded := jsonPlan{}
+
+	err = json.Unmarshal(output.Bytes(), &decoded)
+	require.NoError(t, err)
+
+	require.Len(t, decoded.Priorities, 24)
+	require.Equal(t, "sampling", decoded.Method)
+	require.Less(t, decoded.TotalCombinations, 1<<24-1)
+	require.Len(t, decoded.Combinations, 5)
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"-priorities", "3,a"},
+		{"-priorities", "3,3"},
+		{"-priorities", "3", "-divider", "unknown"},
+		{"-priorities", "3", "-method", "unknown"},
+		{"-priorities", "3", "-divider", "rate", "-method", "fair"},
+		{"-priorities", "3", "-format", "xml"},
+		{"-priorities", "3", "-min", "0"},
+		{"-priorities", "3", "-min", "10", "-max", "1"},
+		{"-priorities", "3", "-tolerance", "-1"},
+		{"-unknown"},
+	}
+
+	for _, args := range tests {
+		require.Error(t, run(args, io.Discard, io.Discard), "args: %v", args)
+	}
+
+	require.ErrorIs(t, run([]string{"-h"}, io.Discard, io.Discard), flag.ErrHelp)
+
+	require.ErrorIs(
+		t,
+		run(
+			[]string{"-priorities", "17,16,15,14,13,12,11,10,9,8,7,6,5,4,3,2,1"},
+			io.Discard,
+			io.Discard,
+		),
+		ErrTooManyPriorities,
+	)
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/akramarenkov/cqos/v2/priority/utils"
+)
+
+type jsonPlan struct {
+	Combinations      []jsonCombination `json:"combinations"`
+	Divider           string            `json:"divider"`
+	MaxNonFatal       uint              `json:"maxNonFatal"`
+	MaxQuantity       uint              `json:"maxQuantity"`
+	MaxSuitable       uint              `json:"maxSuitable"`
+	Method            string            `json:"method"`
+	MinNonFatal       uint              `json:"minNonFatal"`
+	MinQuantity       uint              `json:"minQuantity"`
+	MinSuitable       uint              `json:"minSuitable"`
+	Priorities        []uint            `json:"priorities"`
+	Quantity          uint              `json:"quantity"`
+	Tolerance         float64           `json:"tolerance"`
+	TotalCombinations int               `json:"totalCombinations"`
+}
+
+type jsonCombination struct {
+	Fatal            bool        `json:"fatal"`
+	MaxRelativeError *float64    `json:"maxRelativeError"`
+	Priorities       []uint      `json:"priorities"`
+	Shares           []jsonShare `json:"shares"`
+}
+
+type jsonShare struct {
+	AbsoluteError float64  `json:"absoluteError"`
+	Actual        uint     `json:"actual"`
+	Ideal         float64  `json:"ideal"`
+	Priority      uint     `json:"priority"`
+	RelativeError *float64 `json:"relativeError"`
+}
+
+func writePlan(writer io.Writer, cfg config, plan plan) error {
+	if cfg.Format == formatJSON {
+		return writeJSON(writer, cfg, plan)
+	}
+
+	return writeText(writer, cfg, plan)
+}
+
+func writeText(writer io.Writer, cfg config, plan plan) error {
+	_, err := fmt.Fprintf(
+		writer,
+		"Priorities: %v\n"+
+			"Divider: %s\n"+
+			"Method: %s\n"+
+			"Range of quantity of handlers: %d-%d\n"+
+			"Tolerance: %g%%\n"+
+			"Min non-fatal quantity: %s\n"+
+			"Max non-fatal quantity: %s\n"+
+			"Min suitable quantity: %s\n"+
+			"Max suitable quantity: %s\n"+
+			"\n"+
+			"Distribution for %d handlers (%d of %d combinations):\n",
+		cfg.Priorities,
+		cfg.DividerName,
+		cfg.MethodName,
+		cfg.MinQuantity,
+		cfg.MaxQuantity,
+		cfg.Tolerance,
+		formatQuantity(plan.MinNonFatal),
+		formatQuantity(plan.MaxNonFatal),
+		formatQuantity(plan.MinSuitable),
+		formatQuantity(plan.MaxSuitable),
+		plan.Quantity,
+		len(plan.Combinations),
+		plan.TotalCombinations,
+	)
+	if err != nil {
+		return err
+	}
+
+	report := utils.Report{
+		Combinations: plan.Combinations,
+		Quantity:     plan.Quantity,
+	}
+
+	return report.WriteTable(writer)
+}
+
+func formatQuantity(quantity uint) string {
+	if quantity == 0 {
+		return "not found"
+	}
+
+	return fmt.Sprint(quantity)
+}
+
+func writeJSON(writer io.Writer, cfg config, plan plan) error {
+	converted := jsonPlan{
+		Combinations:      make([]jsonCombination, 0, len(plan.Combinations)),
+		Divider:           cfg.DividerName,
+		MaxNonFatal:       plan.MaxNonFatal,
+		MaxQuantity:       cfg.MaxQuantity,
+		MaxSuitable:       plan.MaxSuitable,
+		Method:            cfg.MethodName,
+		MinNonFatal:       plan.MinNonFatal,
+		MinQuantity:       cfg.MinQuantity,
+		MinSuitable:       plan.MinSuitable,
+		Priorities:        cfg.Priorities,
+		Quantity:          plan.Quantity,
+		Tolerance:         cfg.Tolerance,
+		TotalCombinations: plan.TotalCombinations,
+	}
+
+	for _, combination := range plan.Combinations {
+		converted.Combinations = append(
+			converted.Combinations,
+			convertCombination(combination),
+		)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(converted)
+}
+
+func convertCombination(combination utils.Combination) jsonCombination {
+	converted := jsonCombination{
+		Fatal:            combination.Fatal,
+		MaxRelativeError: finiteOrNil(combination.MaxRelativeError),
+		Priorities:       combination.Priorities,
+		Shares:           make([]jsonShare, 0, len(combination.Shares)),
+	}
+
+	for _, share := range combination.Shares {
+		item := jsonShare{
+			AbsoluteError: share.AbsoluteError,
+			Actual:        share.Actual,
+			Ideal:         share.Ideal,
+			Priority:      share.Priority,
+			RelativeError: finiteOrNil(share.RelativeError),
+		}
+
+		converted.Shares = append(converted.Shares, item)
+	}
+
+	return converted
+}
+
+// JSON does not support infinity, so an infinite error is represented as null.
+func finiteOrNil(value float64) *float64 {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return nil
+	}
+
+	return &value
+}
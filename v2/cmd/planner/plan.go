@@ -0,0 +1,81 @@
+package main
+
+import (
+	"github.com/akramarenkov/cqos/v2/priority/utils"
+)
+
+type plan struct {
+	Combinations      []utils.Combination
+	MaxNonFatal       uint
+	MaxSuitable       uint
+	MinNonFatal       uint
+	MinSuitable       uint
+	Quantity          uint
+	TotalCombinations int
+}
+
+func createPlan(cfg config) (plan, error) {
+	opts := utils.CheckerOpts{
+		Divider:    cfg.Divider,
+		Method:     cfg.Method,
+		Priorities: cfg.Priorities,
+	}
+
+	checker, err := utils.NewChecker(opts)
+	if err != nil {
+		return plan{}, err
+	}
+
+	created := plan{}
+
+	for quantity := cfg.MinQuantity; quantity <= cfg.MaxQuantity; quantity++ {
+		if checker.IsNonFatal(quantity) {
+			if created.MinNonFatal == 0 {
+				created.MinNonFatal = quantity
+			}
+
+			created.MaxNonFatal = quantity
+		}
+
+		if checker.IsSuitable(quantity, cfg.Tolerance) {
+			if created.MinSuitable == 0 {
+				created.MinSuitable = quantity
+			}
+
+			created.MaxSuitable = quantity
+		}
+
+		// Protection against overflow when the maximum quantity is equal
+		// to the maximum value of the type
+		if quantity == cfg.MaxQuantity {
+			break
+		}
+	}
+
+	created.Quantity = pickUpTableQuantity(cfg, created)
+
+	// Only the checked combinations are analyzed, because the quantity of all
+	// combinations grows as 2^n
+	report := checker.Analyze(created.Quantity)
+
+	created.Combinations = report.Combinations
+	created.TotalCombinations = len(report.Combinations)
+
+	if cfg.Worst > 0 {
+		created.Combinations = report.Worst(cfg.Worst)
+	}
+
+	return created, nil
+}
+
+func pickUpTableQuantity(cfg config, created plan) uint {
+	if cfg.Quantity != 0 {
+		return cfg.Quantity
+	}
+
+	if created.MinSuitable != 0 {
+		return created.MinSuitable
+	}
+
+	return cfg.MaxQuantity
+}
@@ -0,0 +1,174 @@
+// Command simulator plays a workload scenario against the prioritization discipline
+// and, for comparison, against a discipline that does not manage data according to
+// their priority. For each discipline it writes an HTML report with charts and
+// prints a summary.
+//
+// Usage:
+//
+//	simulator -scenario scenario.yaml -output reports
+//
+// Flags:
+//
+//	-scenario    path to the scenario file in JSON (.json) or YAML (.yaml, .yml)
+//	             format
+//	-output      directory to which the HTML reports are written (default ".")
+//	-baseline    also play the scenario against the unmanaged discipline
+//	             (default true)
+//	-resolution  duration of the time span in which events are counted on
+//	             the charts (default 100ms)
+//
+// Scenario example:
+//
+//	divider: rate
+//	handlers: 6
+//	inputCapacity: 10
+//	priorities:
+//	  - priority: 3
+//	    processingTime: 10ms
+//	    actions:
+//	      - write: 500
+//	  - priority: 1
+//	    processingTime: 10ms
+//	    actions:
+//	      - delay: 1s
+//	      - write: 500
+//	        delay: 1ms
+//	      - waitDevastation: true
+//	      - write: 100
+//
+// Actions of each priority are performed sequentially: write writes the specified
+// quantity of data elements, with delay after each of them if it is specified,
+// delay alone pauses the writer, waitDevastation waits until the input channel
+// becomes empty.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/analysis"
+)
+
+var (
+	ErrScenarioEmpty = errors.New("scenario was not specified")
+)
+
+const (
+	reportFileMode = 0o644
+)
+
+type config struct {
+	Baseline   bool
+	Output     string
+	Resolution time.Duration
+	Scenario   string
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+		}
+
+		os.Exit(1)
+	}
+}
+
+func run(args []string, output io.Writer, errput io.Writer) error {
+	cfg, err := parseConfig(args, errput)
+	if err != nil {
+		return err
+	}
+
+	scn, err := loadScenario(cfg.Scenario)
+	if err != nil {
+		return err
+	}
+
+	names := []string{disciplineManaged}
+
+	if cfg.Baseline {
+		names = append(names, disciplineUnmanaged)
+	}
+
+	summaries := make([]summary, 0, len(names))
+
+	for _, name := range names {
+		smr, err := simulate(cfg, scn, name)
+		if err != nil {
+			return err
+		}
+
+		summaries = append(summaries, smr)
+	}
+
+	return writeSummaries(output, summaries)
+}
+
+func parseConfig(args []string, errput io.Writer) (config, error) {
+	cfg := config{}
+
+	flags := flag.NewFlagSet("simulator", flag.ContinueOnError)
+	flags.SetOutput(errput)
+
+	flags.StringVar(&cfg.Scenario, "scenario", "", "path to the scenario file")
+	flags.StringVar(&cfg.Output, "output", ".", "directory to which the reports are written")
+	flags.BoolVar(&cfg.Baseline, "baseline", true, "also play against the unmanaged discipline")
+	flags.DurationVar(
+		&cfg.Resolution,
+		"resolution",
+		analysis.DefaultResolution,
+		"duration of the time span in which events are counted on the charts",
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	if cfg.Scenario == "" {
+		return config{}, ErrScenarioEmpty
+	}
+
+	return cfg, nil
+}
+
+func simulate(cfg config, scn scenario, name string) (summary, error) {
+	events, err := play(scn, name)
+	if err != nil {
+		return summary{}, err
+	}
+
+	path := filepath.Join(cfg.Output, name+".html")
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, reportFileMode)
+	if err != nil {
+		return summary{}, err
+	}
+
+	opts := analysis.ReportOpts{
+		Resolution: cfg.Resolution,
+		Subtitle: fmt.Sprintf(
+			"Divider: %s, handlers quantity: %d, input capacity: %d",
+			scn.Divider,
+			scn.Handlers,
+			scn.InputCapacity,
+		),
+		Title: "Discipline: " + name,
+	}
+
+	if err := analysis.WriteReport(file, events, opts); err != nil {
+		_ = file.Close()
+		return summary{}, err
+	}
+
+	if err := file.Close(); err != nil {
+		return summary{}, err
+	}
+
+	return summarize(name, events), nil
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/akramarenkov/cqos/v2/recorder"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	directory := t.TempDir()
+
+	args := []string{
+		"-scenario", "testdata/scenario.yaml",
+		"-output", directory,
+	}
+
+	output := &bytes.Buffer{}
+
+	err := run(args, output, io.Discard)
+	require.NoError(t, err)
+
+	for _, name := range []string{disciplineManaged, disciplineUnmanaged} {
+		report, err := os.ReadFile(filepath.Join(directory, name+".html"))
+		require.NoError(t, err)
+		require.Contains(t, string(report), "Discipline: "+name)
+		require.Regexp(t, name+` +total`, output.String())
+	}
+}
+
+func TestPlay(t *testing.T) {
+	testPlay(t, "testdata/scenario.yaml", map[uint]uint{3: 50, 2: 40, 1: 20})
+	testPlay(t, "testdata/scenario.json", map[uint]uint{2: 20, 1: 10})
+}
+
+func testPlay(t *testing.T, path string, expected map[uint]uint) {
+	scn, err := loadScenario(path)
+	require.NoError(t, err)
+
+	for _, name := range []string{disciplineManaged, disciplineUnmanaged} {
+		events, err := play(scn, name)
+		require.NoError(t, err)
+		// Received, processed and completed events for each data element
+		require.Len(t, events, 3*int(scn.itemsQuantity()))
+
+		items := make(map[uint]uint)
+
+		for _, priority := range summarize(name, events).Priorities {
+			items[priority.Priority] = priority.Items
+		}
+
+		require.Equal(t, expected, items, "discipline: %v", name)
+	}
+}
+
+func TestRunWithoutBaseline(t *testing.T) {
+	directory := t.TempDir()
+
+	args := []string{
+		"-scenario", "testdata/scenario.json",
+		"-output", directory,
+		"-baseline=false",
+	}
+
+	err := run(args, io.Discard, io.Discard)
+	require.NoError(t, err)
+
+	require.FileExists(t, filepath.Join(directory, disciplineManaged+".html"))
+	require.NoFileExists(t, filepath.Join(directory, disciplineUnmanaged+".html"))
+}
+
+func TestRunErrors(t *testing.T) {
+	directory := t.TempDir()
+
+	tests := [][]string{
+		{},
+		{"-unknown"},
+		{"-scenario", filepath.Join(directory, "not-exists.yaml")},
+		{"-scenario", "testdata/scenario.json", "-output", filepath.Join(directory, "absent")},
+	}
+
+	for _, args := range tests {
+		require.Error(t, run(args, io.Discard, io.Discard), "args: %v", args)
+	}
+
+	require.ErrorIs(t, run([]string{"-h"}, io.Discard, io.Discard), flag.ErrHelp)
+}
+
+func TestDecodeScenario(t *testing.T) {
+	tests := []struct {
+		format   string
+		scenario string
+		err      error
+	}{
+		{
+			format:   ".toml",
+			scenario: "",
+			err:      ErrScenarioFormatUnknown,
+		},
+		{
+			format:   ".json",
+			scenario: `{"divider": "unknown", "handlers": 1, "priorities": [{"priority": 1}]}`,
+			err:      ErrDividerUnknown,
+		},
+		{
+			format:   ".json",
+			scenario: `{"divider": "fair", "priorities": [{"priority": 1}]}`,
+			err:      ErrHandlersZero,
+		},
+		{
+			format:   ".json",
+			scenario: `{"divider": "fair", "handlers": 1}`,
+			err:      ErrPrioritiesEmpty,
+		},
+		{
+			format:   ".yaml",
+			scenario: "{divider: fair, handlers: 1, priorities: [{priority: 1}, {priority: 1}]}",
+			err:      ErrPriorityDuplicate,
+		},
+		{
+			format: ".yml",
+			scenario: "{divider: fair, handlers: 1, priorities: " +
+				"[{priority: 1, actions: [{}]}]}",
+			err: ErrActionEmpty,
+		},
+		{
+			format: ".yml",
+			scenario: "{divider: fair, handlers: 1, priorities: " +
+				"[{priority: 1, actions: [{write: 1, waitDevastation: true}]}]}",
+			err: ErrActionAmbiguous,
+		},
+		{
+			format: ".json",
+			scenario: `{"divider": "fair", "handlers": 1, "priorities": ` +
+				`[{"priority": 1, "processingTime": "-1s"}]}`,
+			err: ErrDurationNegative,
+		},
+		{
+			format: ".json",
+			scenario: `{"divider": "fair", "handlers": 1, "priorities": ` +
+				`[{"priority": 1, "actions": [{"delay": "1s"}]}]}`,
+			err: ErrWritesEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		_, err := decodeScenario(strings.NewReader(test.scenario), test.format)
+		require.ErrorIs(t, err, test.err, "scenario: %v", test.scenario)
+	}
+
+	_, err := decodeScenario(strings.NewReader(`{"unknown": 1}`), ".json")
+	require.Error(t, err)
+
+	_, err = decodeScenario(strings.NewReader("unknown: 1"), ".yaml")
+	require.Error(t, err)
+}
+
+func TestSummarize(t *testing.T) {
+	events := []recorder.Event{
+		{Kind: recorder.KindReceived, Priority: 1, RelativeTime: 2},
+		{Kind: recorder.KindReceived, Priority: 2, RelativeTime: 1},
+		{Kind: recorder.KindProcessed, Priority: 2, RelativeTime: 3},
+		{Kind: recorder.KindCompleted, Priority: 2, RelativeTime: 4},
+		{Kind: recorder.KindProcessed, Priority: 1, RelativeTime: 5},
+		{Kind: recorder.KindCompleted, Priority: 1, RelativeTime: 6},
+	}
+
+	expected := summary{
+		Discipline: disciplineManaged,
+		Duration:   6,
+		Priorities: []prioritySummary{
+			{FirstReceived: 1, Items: 1, LastCompleted: 4, Priority: 2},
+			{FirstReceived: 2, Items: 1, LastCompleted: 6, Priority: 1},
+		},
+	}
+
+	require.Equal(t, expected, summarize(disciplineManaged, events))
+}
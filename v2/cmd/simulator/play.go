@@ -0,0 +1,76 @@
+package main
+
+import (
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/cqostest"
+	"github.com/akramarenkov/cqos/v2/priority"
+	"github.com/akramarenkov/cqos/v2/recorder"
+)
+
+const (
+	disciplineManaged   = "priority"
+	disciplineUnmanaged = "unmanaged"
+)
+
+// Plays the scenario against the discipline with the specified name and returns
+// the recorded events.
+func play(scn scenario, name string) ([]recorder.Event, error) {
+	measurerOpts := cqostest.MeasurerOpts{
+		HandlersQuantity: scn.Handlers,
+		InputCapacity:    scn.InputCapacity,
+	}
+
+	msr := cqostest.NewMeasurer(measurerOpts)
+
+	for _, priority := range scn.Priorities {
+		addActions(msr, priority)
+	}
+
+	discipline, err := createDiscipline(scn, name, msr.GetInputs())
+	if err != nil {
+		return nil, err
+	}
+
+	return msr.Play(discipline), nil
+}
+
+func addActions(msr *cqostest.Measurer, priority priorityScenario) {
+	msr.SetProcessDelay(priority.Priority, time.Duration(priority.ProcessingTime))
+
+	for _, action := range priority.Actions {
+		switch {
+		case action.WaitDevastation:
+			msr.AddWaitDevastation(priority.Priority)
+		case action.Write == 0:
+			msr.AddDelay(priority.Priority, time.Duration(action.Delay))
+		case action.Delay == 0:
+			msr.AddWrite(priority.Priority, action.Write)
+		default:
+			msr.AddWriteWithDelay(priority.Priority, action.Write, time.Duration(action.Delay))
+		}
+	}
+}
+
+func createDiscipline(
+	scn scenario,
+	name string,
+	inputs map[uint]<-chan uint,
+) (recorder.PriorityDiscipline[uint], error) {
+	if name == disciplineUnmanaged {
+		opts := cqostest.UnmanagedOpts[uint]{
+			HandlersQuantity: scn.Handlers,
+			Inputs:           inputs,
+		}
+
+		return cqostest.NewUnmanaged(opts)
+	}
+
+	opts := priority.Opts[uint]{
+		Divider:          dividers()[scn.Divider],
+		HandlersQuantity: scn.Handlers,
+		Inputs:           inputs,
+	}
+
+	return priority.New(opts)
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrActionAmbiguous       = errors.New("action contains more than one kind of operation")
+	ErrActionEmpty           = errors.New("action does not contain any operation")
+	ErrDividerUnknown        = errors.New("dividing function is unknown")
+	ErrDurationNegative      = errors.New("duration is negative")
+	ErrHandlersZero          = errors.New("handlers quantity is zero")
+	ErrPrioritiesEmpty       = errors.New("priorities was not specified")
+	ErrPriorityDuplicate     = errors.New("priority is duplicated")
+	ErrScenarioFormatUnknown = errors.New("scenario format is unknown")
+	ErrWritesEmpty           = errors.New("no data elements are written in the scenario")
+)
+
+const (
+	defaultInputCapacity = 100
+)
+
+// Duration that is specified in a scenario as a string, for example, "10ms".
+type duration time.Duration
+
+func (drt *duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	if parsed < 0 {
+		return ErrDurationNegative
+	}
+
+	*drt = duration(parsed)
+
+	return nil
+}
+
+func (drt duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(drt).String()), nil
+}
+
+// Operation performed by the writer of a priority.
+//
+// If only Write is specified, the data elements are written without pauses. If
+// Write and Delay are specified, there is a pause after writing each data element.
+// If only Delay is specified, the writer pauses. If WaitDevastation is specified,
+// the writer waits until the input channel becomes empty.
+type action struct {
+	Delay           duration `json:"delay"           yaml:"delay"`
+	WaitDevastation bool     `json:"waitDevastation" yaml:"waitDevastation"`
+	Write           uint     `json:"write"           yaml:"write"`
+}
+
+type priorityScenario struct {
+	Actions        []action `json:"actions"        yaml:"actions"`
+	Priority       uint     `json:"priority"       yaml:"priority"`
+	ProcessingTime duration `json:"processingTime" yaml:"processingTime"`
+}
+
+type scenario struct {
+	Divider       string             `json:"divider"       yaml:"divider"`
+	Handlers      uint               `json:"handlers"      yaml:"handlers"`
+	InputCapacity uint               `json:"inputCapacity" yaml:"inputCapacity"`
+	Priorities    []priorityScenario `json:"priorities"    yaml:"priorities"`
+}
+
+func loadScenario(path string) (scenario, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return scenario{}, err
+	}
+
+	defer file.Close()
+
+	format := strings.ToLower(filepath.Ext(path))
+
+	return decodeScenario(file, format)
+}
+
+func decodeScenario(reader io.Reader, format string) (scenario, error) {
+	decoded := scenario{}
+
+	switch format {
+	case ".json":
+		decoder := json.NewDecoder(reader)
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&decoded); err != nil {
+			return scenario{}, err
+		}
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(reader)
+		decoder.KnownFields(true)
+
+		if err := decoder.Decode(&decoded); err != nil {
+			return scenario{}, err
+		}
+	default:
+		return scenario{}, ErrScenarioFormatUnknown
+	}
+
+	if err := decoded.isValid(); err != nil {
+		return scenario{}, err
+	}
+
+	return decoded.normalize(), nil
+}
+
+func (scn scenario) isValid() error {
+	if _, exists := dividers()[scn.Divider]; !exists {
+		return ErrDividerUnknown
+	}
+
+	if scn.Handlers == 0 {
+		return ErrHandlersZero
+	}
+
+	if len(scn.Priorities) == 0 {
+		return ErrPrioritiesEmpty
+	}
+
+	priorities := make(map[uint]struct{}, len(scn.Priorities))
+
+	for _, priority := range scn.Priorities {
+		if _, exists := priorities[priority.Priority]; exists {
+			return ErrPriorityDuplicate
+		}
+
+		priorities[priority.Priority] = struct{}{}
+
+		for _, action := range priority.Actions {
+			if err := action.isValid(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if scn.itemsQuantity() == 0 {
+		return ErrWritesEmpty
+	}
+
+	return nil
+}
+
+func (scn scenario) normalize() scenario {
+	if scn.InputCapacity == 0 {
+		scn.InputCapacity = defaultInputCapacity
+	}
+
+	return scn
+}
+
+func (act action) isValid() error {
+	if act.WaitDevastation {
+		if act.Write != 0 || act.Delay != 0 {
+			return ErrActionAmbiguous
+		}
+
+		return nil
+	}
+
+	if act.Write == 0 && act.Delay == 0 {
+		return ErrActionEmpty
+	}
+
+	return nil
+}
+
+func dividers() map[string]divider.Divider {
+	return map[string]divider.Divider{
+		"fair": divider.Fair,
+		"rate": divider.Rate,
+	}
+}
+
+// Returns the total quantity of data elements written in the scenario.
+func (scn scenario) itemsQuantity() uint {
+	quantity := uint(0)
+
+	for _, priority := range scn.Priorities {
+		for _, action := range priority.Actions {
+			quantity += action.Write
+		}
+	}
+
+	return quantity
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/recorder"
+)
+
+type prioritySummary struct {
+	FirstReceived time.Duration
+	Items         uint
+	LastCompleted time.Duration
+	Priority      uint
+}
+
+type summary struct {
+	Discipline string
+	Duration   time.Duration
+	Priorities []prioritySummary
+}
+
+func summarize(name string, events []recorder.Event) summary {
+	summaries := make(map[uint]*prioritySummary)
+
+	smr := summary{
+		Discipline: name,
+	}
+
+	for _, event := range events {
+		current, exists := summaries[event.Priority]
+		if !exists {
+			current = &prioritySummary{
+				FirstReceived: event.RelativeTime,
+				Priority:      event.Priority,
+			}
+
+			summaries[event.Priority] = current
+		}
+
+		switch event.Kind {
+		case recorder.KindReceived:
+			current.FirstReceived = min(current.FirstReceived, event.RelativeTime)
+		case recorder.KindCompleted:
+			current.Items++
+			current.LastCompleted = max(current.LastCompleted, event.RelativeTime)
+			smr.Duration = max(smr.Duration, event.RelativeTime)
+		}
+	}
+
+	for _, current := range summaries {
+		smr.Priorities = append(smr.Priorities, *current)
+	}
+
+	// From highest to lowest priority
+	slices.SortFunc(smr.Priorities, func(first, second prioritySummary) int {
+		return int(second.Priority) - int(first.Priority)
+	})
+
+	return smr
+}
+
+func writeSummaries(writer io.Writer, summaries []summary) error {
+	const (
+		minWidth = 0
+		tabWidth = 0
+		padding  = 2
+		padChar  = ' '
+		flags    = 0
+	)
+
+	const (
+		header = "discipline\tpriority\titems\tfirst received\tlast completed\n"
+	)
+
+	table := tabwriter.NewWriter(writer, minWidth, tabWidth, padding, padChar, flags)
+
+	if _, err := fmt.Fprint(table, header); err != nil {
+		return err
+	}
+
+	for _, smr := range summaries {
+		for _, priority := range smr.Priorities {
+			_, err := fmt.Fprintf(
+				table,
+				"%s\t%d\t%d\t%s\t%s\n",
+				smr.Discipline,
+				priority.Priority,
+				priority.Items,
+				priority.FirstReceived,
+				priority.LastCompleted,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := fmt.Fprintf(table, "%s\ttotal\t\t\t%s\n", smr.Discipline, smr.Duration)
+		if err != nil {
+			return err
+		}
+	}
+
+	return table.Flush()
+}
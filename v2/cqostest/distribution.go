@@ -0,0 +1,174 @@
+package cqostest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/internal/consts"
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+	"github.com/akramarenkov/cqos/v2/recorder"
+)
+
+var (
+	ErrDistributionMismatch = errors.New("distribution of handlers does not match the divider")
+	ErrSpanEmpty            = errors.New("there is no time span in which all priorities are received")
+)
+
+type eventKey struct {
+	data     uint
+	priority uint
+}
+
+// Calculates the actual distribution of handlers among priorities from
+// the recorded events.
+//
+// The distribution is calculated in the time span in which the data elements of
+// all priorities are received by handlers, that is, from the latest first
+// recorder.KindReceived event to the earliest last recorder.KindReceived event
+// among the priorities. The quantity of handlers of a priority is the total time
+// that its data elements spent in processing (between the recorder.KindReceived
+// and recorder.KindCompleted events) within the time span divided by the duration
+// of the time span.
+func CalcDistribution(events []recorder.Event) (map[uint]float64, error) {
+	priorities, begin, end := calcCommonSpan(events)
+	if end <= begin {
+		return nil, ErrSpanEmpty
+	}
+
+	received := make(map[eventKey]time.Duration)
+	busy := make(map[uint]time.Duration)
+
+	for _, event := range events {
+		key := eventKey{
+			data:     event.Data,
+			priority: event.Priority,
+		}
+
+		switch event.Kind {
+		case recorder.KindReceived:
+			received[key] = event.RelativeTime
+		case recorder.KindCompleted:
+			receivedAt, exists := received[key]
+			if !exists {
+				continue
+			}
+
+			from := max(receivedAt, begin)
+			to := min(event.RelativeTime, end)
+
+			if to > from {
+				busy[event.Priority] += to - from
+			}
+		}
+	}
+
+	distribution := make(map[uint]float64, len(priorities))
+
+	for _, priority := range priorities {
+		distribution[priority] = float64(busy[priority]) / float64(end-begin)
+	}
+
+	return distribution, nil
+}
+
+func calcCommonSpan(events []recorder.Event) ([]uint, time.Duration, time.Duration) {
+	firsts := make(map[uint]time.Duration)
+	lasts := make(map[uint]time.Duration)
+
+	for _, event := range events {
+		if event.Kind != recorder.KindReceived {
+			continue
+		}
+
+		if first, exists := firsts[event.Priority]; !exists || event.RelativeTime < first {
+			firsts[event.Priority] = event.RelativeTime
+		}
+
+		lasts[event.Priority] = max(lasts[event.Priority], event.RelativeTime)
+	}
+
+	if len(firsts) == 0 {
+		return nil, 0, 0
+	}
+
+	priorities := make([]uint, 0, len(firsts))
+
+	begin := time.Duration(math.MinInt64)
+	end := time.Duration(math.MaxInt64)
+
+	for priority := range firsts {
+		begin = max(begin, firsts[priority])
+		end = min(end, lasts[priority])
+
+		priorities = append(priorities, priority)
+	}
+
+	return priorities, begin, end
+}
+
+// Checks that the actual distribution of handlers among priorities, calculated by
+// the CalcDistribution function, matches the distribution calculated by
+// the divider for the specified quantity of handlers. The relative difference for
+// each priority must not exceed the tolerance, specified as a percentage.
+//
+// For the check to make sense, the handlers must be constantly busy in the time
+// span in which the data elements of all priorities are received, that is,
+// the input channels must not be empty and the processing time must be
+// significantly greater than the overhead of the discipline.
+func CheckDistribution(
+	events []recorder.Event,
+	divider divider.Divider,
+	handlersQuantity uint,
+	tolerance float64,
+) error {
+	actual, err := CalcDistribution(events)
+	if err != nil {
+		return err
+	}
+
+	priorities := make([]uint, 0, len(actual))
+
+	for priority := range actual {
+		priorities = append(priorities, priority)
+	}
+
+	// From highest to lowest priority, as in the prioritization discipline
+	slices.Sort(priorities)
+	slices.Reverse(priorities)
+
+	expected := make(map[uint]uint, len(priorities))
+
+	divider(priorities, handlersQuantity, expected)
+
+	for _, priority := range priorities {
+		deviation := calcDeviation(float64(expected[priority]), actual[priority])
+
+		if deviation > tolerance {
+			return fmt.Errorf(
+				"%w: priority: %d, expected: %d, actual: %.3f, deviation: %.2f%%",
+				ErrDistributionMismatch,
+				priority,
+				expected[priority],
+				actual[priority],
+				deviation,
+			)
+		}
+	}
+
+	return nil
+}
+
+func calcDeviation(expected float64, actual float64) float64 {
+	if expected == 0 {
+		if actual == 0 {
+			return 0
+		}
+
+		return math.Inf(1)
+	}
+
+	return consts.HundredPercent * math.Abs(actual-expected) / expected
+}
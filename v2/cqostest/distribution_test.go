@@ -0,0 +1,118 @@
+package cqostest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/priority"
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+	"github.com/akramarenkov/cqos/v2/recorder"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcDistribution(t *testing.T) {
+	events := []recorder.Event{
+		{Data: 0, Kind: recorder.KindReceived, Priority: 2, RelativeTime: 0},
+		{Data: 1, Kind: recorder.KindReceived, Priority: 2, RelativeTime: 0},
+		{Data: 0, Kind: recorder.KindReceived, Priority: 1, RelativeTime: 10},
+		{Data: 0, Kind: recorder.KindCompleted, Priority: 2, RelativeTime: 20},
+		{Data: 1, Kind: recorder.KindCompleted, Priority: 2, RelativeTime: 20},
+		{Data: 0, Kind: recorder.KindCompleted, Priority: 1, RelativeTime: 20},
+		{Data: 2, Kind: recorder.KindReceived, Priority: 2, RelativeTime: 20},
+		{Data: 1, Kind: recorder.KindReceived, Priority: 1, RelativeTime: 20},
+		{Data: 2, Kind: recorder.KindCompleted, Priority: 2, RelativeTime: 30},
+		{Data: 1, Kind: recorder.KindCompleted, Priority: 1, RelativeTime: 30},
+	}
+
+	// Common span is [10, 20]
+	expected := map[uint]float64{
+		2: 2,
+		1: 1,
+	}
+
+	distribution, err := CalcDistribution(events)
+	require.NoError(t, err)
+	require.InDeltaMapValues(t, expected, distribution, 1e-9)
+
+	require.NoError(t, CheckDistribution(events, divider.Rate, 3, 0))
+	require.ErrorIs(t, CheckDistribution(events, divider.Fair, 4, 10), ErrDistributionMismatch)
+
+	_, err = CalcDistribution(nil)
+	require.ErrorIs(t, err, ErrSpanEmpty)
+
+	_, err = CalcDistribution(events[:3])
+	require.ErrorIs(t, err, ErrSpanEmpty)
+
+	require.ErrorIs(t, CheckDistribution(nil, divider.Rate, 3, 0), ErrSpanEmpty)
+}
+
+func TestCalcDeviation(t *testing.T) {
+	require.InDelta(t, 0.0, calcDeviation(0, 0), 1e-9)
+	require.InDelta(t, 50.0, calcDeviation(2, 1), 1e-9)
+	require.InDelta(t, 50.0, calcDeviation(2, 3), 1e-9)
+	require.Greater(t, calcDeviation(0, 1), 1e300)
+}
+
+func TestCheckDistribution(t *testing.T) {
+	const (
+		handlersQuantity = 6
+		tolerance        = 20
+	)
+
+	measurerOpts := MeasurerOpts{
+		HandlersQuantity: handlersQuantity,
+	}
+
+	msr := NewMeasurer(measurerOpts)
+
+	for _, priority := range []uint{3, 2, 1} {
+		msr.AddWrite(priority, 300)
+		msr.SetProcessDelay(priority, time.Millisecond)
+	}
+
+	opts := priority.Opts[uint]{
+		Divider:          divider.Rate,
+		HandlersQuantity: handlersQuantity,
+		Inputs:           msr.GetInputs(),
+	}
+
+	discipline, err := priority.New(opts)
+	require.NoError(t, err)
+
+	events := msr.Play(discipline)
+
+	err = CheckDistribution(events, divider.Rate, handlersQuantity, tolerance)
+	require.NoError(t, err)
+}
+
+func TestCheckDistributionUnmanaged(t *testing.T) {
+	const (
+		handlersQuantity = 6
+		tolerance        = 20
+	)
+
+	measurerOpts := MeasurerOpts{
+		HandlersQuantity: handlersQuantity,
+	}
+
+	msr := NewMeasurer(measurerOpts)
+
+	for _, priority := range []uint{3, 2, 1} {
+		msr.AddWrite(priority, 300)
+		msr.SetProcessDelay(priority, time.Millisecond)
+	}
+
+	opts := UnmanagedOpts[uint]{
+		HandlersQuantity: handlersQuantity,
+		Inputs:           msr.GetInputs(),
+	}
+
+	discipline, err := NewUnmanaged(opts)
+	require.NoError(t, err)
+
+	events := msr.Play(discipline)
+
+	err = CheckDistribution(events, divider.Rate, handlersQuantity, tolerance)
+	require.ErrorIs(t, err, ErrDistributionMismatch)
+}
@@ -0,0 +1,11 @@
+// Package with helpers for testing the disciplines and the code that wraps them.
+//
+// For the join disciplines it contains generators of a sequence of numbers in
+// the form of input blocks and predictions of how they will be processed by
+// the disciplines.
+//
+// For the prioritization discipline it contains a scripted workload driver, a
+// discipline that does not manage data according to their priority, which can be
+// used as a baseline, and checking of the distribution of handlers among
+// priorities.
+package cqostest
@@ -1,4 +1,4 @@
-package inspect
+package cqostest
 
 type description struct {
 	EffectiveJoinSize int
@@ -1,4 +1,4 @@
-package inspect
+package cqostest
 
 // Returns a sequence of numbers starting from 1 to value of 'quantity' inclusive
 // divided into blocks which should be supplied to the input of the discipline.
@@ -1,5 +1,4 @@
-// Internal package with implementation of the Measurer which is used for testing.
-package measurer
+package cqostest
 
 import (
 	"context"
@@ -7,6 +6,7 @@ import (
 	"time"
 
 	"github.com/akramarenkov/cqos/v2/priority/types"
+	"github.com/akramarenkov/cqos/v2/recorder"
 	"github.com/akramarenkov/starter"
 )
 
@@ -34,14 +34,19 @@ type action struct {
 	quantity uint
 }
 
-type Opts struct {
-	DisableMeasures  bool
+// Options of the created measurer.
+type MeasurerOpts struct {
+	// Disables recording of events, used in benchmarks
+	DisableMeasures bool
+	// Quantity of handlers that receive data elements from the discipline
 	HandlersQuantity uint
-	InputCapacity    uint
-	UnbufferedInput  bool
+	// Capacity of the input channels. By default, it is equal to 100
+	InputCapacity uint
+	// Makes the input channels unbuffered regardless of the InputCapacity
+	UnbufferedInput bool
 }
 
-func (opts Opts) normalize() Opts {
+func (opts MeasurerOpts) normalize() MeasurerOpts {
 	if opts.InputCapacity == 0 {
 		opts.InputCapacity = defaultChannelCapacity
 	}
@@ -53,8 +58,15 @@ func (opts Opts) normalize() Opts {
 	return opts
 }
 
+// Scripted workload driver for the prioritization discipline.
+//
+// Writes data elements to the input channels of the discipline according to
+// the actions added for each priority, receives them from the discipline by
+// the specified quantity of handlers and records the events of their processing.
+//
+// The data elements are the sequence numbers of the elements within the priority.
 type Measurer struct {
-	opts Opts
+	opts MeasurerOpts
 
 	inputs map[uint]chan uint
 
@@ -62,7 +74,8 @@ type Measurer struct {
 	delays  map[uint]time.Duration
 }
 
-func New(opts Opts) *Measurer {
+// Creates measurer.
+func NewMeasurer(opts MeasurerOpts) *Measurer {
 	msr := &Measurer{
 		opts: opts.normalize(),
 
@@ -85,6 +98,8 @@ func (msr *Measurer) addAction(priority uint, action action) {
 	msr.actions[priority] = append(msr.actions[priority], action)
 }
 
+// Adds writing of the specified quantity of data elements to the input channel of
+// the priority.
 func (msr *Measurer) AddWrite(priority uint, quantity uint) {
 	msr.updateInput(priority)
 
@@ -96,6 +111,8 @@ func (msr *Measurer) AddWrite(priority uint, quantity uint) {
 	msr.addAction(priority, action)
 }
 
+// Adds writing of the specified quantity of data elements to the input channel of
+// the priority with a delay after writing each data element.
 func (msr *Measurer) AddWriteWithDelay(priority uint, quantity uint, delay time.Duration) {
 	msr.updateInput(priority)
 
@@ -108,6 +125,7 @@ func (msr *Measurer) AddWriteWithDelay(priority uint, quantity uint, delay time.
 	msr.addAction(priority, action)
 }
 
+// Adds waiting until the input channel of the priority becomes empty.
 func (msr *Measurer) AddWaitDevastation(priority uint) {
 	action := action{
 		kind: actionKindWaitDevastation,
@@ -116,6 +134,7 @@ func (msr *Measurer) AddWaitDevastation(priority uint) {
 	msr.addAction(priority, action)
 }
 
+// Adds a pause in writing to the input channel of the priority.
 func (msr *Measurer) AddDelay(priority uint, delay time.Duration) {
 	action := action{
 		kind:  actionKindDelay,
@@ -125,6 +144,8 @@ func (msr *Measurer) AddDelay(priority uint, delay time.Duration) {
 	msr.addAction(priority, action)
 }
 
+// Sets the duration of processing of the data element of the priority by
+// the handler.
 func (msr *Measurer) SetProcessDelay(priority uint, delay time.Duration) {
 	msr.delays[priority] = delay
 }
@@ -154,6 +175,7 @@ func (msr *Measurer) GetExpectedMeasuresQuantity() uint {
 	return measuresFactor * msr.GetExpectedItemsQuantity()
 }
 
+// Returns input channels that must be passed to the discipline.
 func (msr *Measurer) GetInputs() map[uint]<-chan uint {
 	out := make(map[uint]<-chan uint, len(msr.inputs))
 
@@ -239,8 +261,8 @@ func waitDevastation(ctx context.Context, channel chan uint) bool {
 func (msr *Measurer) runHandlers(
 	ctx context.Context,
 	wg *sync.WaitGroup,
-	channel chan Measure,
-	discipline Discipline[uint],
+	channel chan recorder.Event,
+	discipline recorder.PriorityDiscipline[uint],
 ) {
 	starter := starter.New()
 	defer starter.Go()
@@ -256,9 +278,9 @@ func (msr *Measurer) runHandlers(
 func (msr *Measurer) handler(
 	ctx context.Context,
 	wg *sync.WaitGroup,
-	channel chan Measure,
+	channel chan recorder.Event,
 	starter *starter.Starter,
-	discipline Discipline[uint],
+	discipline recorder.PriorityDiscipline[uint],
 ) {
 	defer wg.Done()
 
@@ -280,19 +302,19 @@ func (msr *Measurer) handler(
 
 func (msr *Measurer) handle(
 	item types.Prioritized[uint],
-	channel chan Measure,
+	channel chan recorder.Event,
 	starter *starter.Starter,
-	discipline Discipline[uint],
+	discipline recorder.PriorityDiscipline[uint],
 ) {
 	if msr.opts.DisableMeasures {
 		discipline.Release(item.Priority)
 		return
 	}
 
-	received := Measure{
+	received := recorder.Event{
 		RelativeTime: time.Since(starter.StartedAt()),
 		Priority:     item.Priority,
-		Kind:         MeasureKindReceived,
+		Kind:         recorder.KindReceived,
 		Data:         item.Item,
 	}
 
@@ -300,10 +322,10 @@ func (msr *Measurer) handle(
 
 	time.Sleep(msr.delays[item.Priority])
 
-	processed := Measure{
+	processed := recorder.Event{
 		RelativeTime: time.Since(starter.StartedAt()),
 		Priority:     item.Priority,
-		Kind:         MeasureKindProcessed,
+		Kind:         recorder.KindProcessed,
 		Data:         item.Item,
 	}
 
@@ -311,27 +333,31 @@ func (msr *Measurer) handle(
 
 	discipline.Release(item.Priority)
 
-	completed := Measure{
+	completed := recorder.Event{
 		RelativeTime: time.Since(starter.StartedAt()),
 		Priority:     item.Priority,
-		Kind:         MeasureKindCompleted,
+		Kind:         recorder.KindCompleted,
 		Data:         item.Item,
 	}
 
 	channel <- completed
 }
 
-func (msr *Measurer) prepare() (chan Measure, []Measure) {
+func (msr *Measurer) prepare() (chan recorder.Event, []recorder.Event) {
 	if msr.opts.DisableMeasures {
-		return make(chan Measure), make([]Measure, 0)
+		return make(chan recorder.Event), make([]recorder.Event, 0)
 	}
 
 	quantity := msr.GetExpectedMeasuresQuantity()
 
-	return make(chan Measure, quantity), make([]Measure, 0, quantity)
+	return make(chan recorder.Event, quantity), make([]recorder.Event, 0, quantity)
 }
 
-func (msr *Measurer) Play(discipline Discipline[uint]) []Measure {
+// Plays the added actions against the discipline and returns the recorded events.
+//
+// Terminates when all expected events are recorded or when the discipline returns
+// an error.
+func (msr *Measurer) Play(discipline recorder.PriorityDiscipline[uint]) []recorder.Event {
 	channel, measures := msr.prepare()
 	defer close(channel)
 
@@ -1,19 +1,18 @@
-package measurer
+package cqostest
 
 import (
 	"testing"
 	"time"
 
-	"github.com/akramarenkov/cqos/v2/priority/internal/unmanaged"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGeneral(t *testing.T) {
-	measurerOpts := Opts{
+	measurerOpts := MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := New(measurerOpts)
+	msr := NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 1000)
 
@@ -31,12 +30,12 @@ func TestGeneral(t *testing.T) {
 	msr.SetProcessDelay(2, 1*time.Millisecond)
 	msr.SetProcessDelay(3, 1*time.Millisecond)
 
-	opts := unmanaged.Opts[uint]{
+	opts := UnmanagedOpts[uint]{
 		HandlersQuantity: measurerOpts.HandlersQuantity,
 		Inputs:           msr.GetInputs(),
 	}
 
-	discipline, err := unmanaged.New(opts)
+	discipline, err := NewUnmanaged(opts)
 	require.NoError(t, err)
 
 	measures := msr.Play(discipline)
@@ -46,22 +45,22 @@ func TestGeneral(t *testing.T) {
 }
 
 func TestWriteWithDelay(t *testing.T) {
-	measurerOpts := Opts{
+	measurerOpts := MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := New(measurerOpts)
+	msr := NewMeasurer(measurerOpts)
 
 	msr.AddWriteWithDelay(1, 1000, 1*time.Millisecond)
 	msr.AddWriteWithDelay(2, 1000, 1*time.Millisecond)
 	msr.AddWriteWithDelay(3, 1000, 1*time.Millisecond)
 
-	opts := unmanaged.Opts[uint]{
+	opts := UnmanagedOpts[uint]{
 		HandlersQuantity: measurerOpts.HandlersQuantity,
 		Inputs:           msr.GetInputs(),
 	}
 
-	discipline, err := unmanaged.New(opts)
+	discipline, err := NewUnmanaged(opts)
 	require.NoError(t, err)
 
 	measures := msr.Play(discipline)
@@ -71,23 +70,23 @@ func TestWriteWithDelay(t *testing.T) {
 }
 
 func TestDisableMeasures(t *testing.T) {
-	measurerOpts := Opts{
+	measurerOpts := MeasurerOpts{
 		HandlersQuantity: 6,
 		DisableMeasures:  true,
 	}
 
-	msr := New(measurerOpts)
+	msr := NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 1000)
 	msr.AddWrite(2, 1000)
 	msr.AddWrite(3, 1000)
 
-	opts := unmanaged.Opts[uint]{
+	opts := UnmanagedOpts[uint]{
 		HandlersQuantity: measurerOpts.HandlersQuantity,
 		Inputs:           msr.GetInputs(),
 	}
 
-	discipline, err := unmanaged.New(opts)
+	discipline, err := NewUnmanaged(opts)
 	require.NoError(t, err)
 
 	measures := msr.Play(discipline)
@@ -98,11 +97,11 @@ func TestDisableMeasures(t *testing.T) {
 }
 
 func TestBufferedInput(t *testing.T) {
-	measurerOpts := Opts{
+	measurerOpts := MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := New(measurerOpts)
+	msr := NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 1000)
 	msr.AddWrite(2, 1000)
@@ -116,12 +115,12 @@ func TestBufferedInput(t *testing.T) {
 }
 
 func TestUnbufferedInput(t *testing.T) {
-	measurerOpts := Opts{
+	measurerOpts := MeasurerOpts{
 		HandlersQuantity: 6,
 		UnbufferedInput:  true,
 	}
 
-	msr := New(measurerOpts)
+	msr := NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 1000)
 	msr.AddWrite(2, 1000)
@@ -135,34 +134,34 @@ func TestUnbufferedInput(t *testing.T) {
 }
 
 func TestFail(t *testing.T) {
-	measurerOpts := Opts{
+	measurerOpts := MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := New(measurerOpts)
+	msr := NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 1000)
 	msr.AddWrite(2, 1000)
 	msr.AddWrite(3, 1000)
 
-	opts := unmanaged.Opts[uint]{
+	opts := UnmanagedOpts[uint]{
 		FailAt:           500,
 		HandlersQuantity: measurerOpts.HandlersQuantity,
 		Inputs:           msr.GetInputs(),
 	}
 
-	discipline, err := unmanaged.New(opts)
+	discipline, err := NewUnmanaged(opts)
 	require.NoError(t, err)
 
 	_ = msr.Play(discipline)
 }
 
 func TestFailAtWaitDevastation(t *testing.T) {
-	measurerOpts := Opts{
+	measurerOpts := MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := New(measurerOpts)
+	msr := NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 500)
 	msr.AddWaitDevastation(1)
@@ -176,13 +175,13 @@ func TestFailAtWaitDevastation(t *testing.T) {
 	msr.AddWaitDevastation(3)
 	msr.AddWrite(3, 500)
 
-	opts := unmanaged.Opts[uint]{
+	opts := UnmanagedOpts[uint]{
 		FailAt:           500,
 		HandlersQuantity: measurerOpts.HandlersQuantity,
 		Inputs:           msr.GetInputs(),
 	}
 
-	discipline, err := unmanaged.New(opts)
+	discipline, err := NewUnmanaged(opts)
 	require.NoError(t, err)
 
 	_ = msr.Play(discipline)
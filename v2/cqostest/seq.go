@@ -1,4 +1,4 @@
-package inspect
+package cqostest
 
 // Used in ongoing tests for brevity.
 func seq(begin int, end int) []int {
@@ -0,0 +1,131 @@
+package cqostest
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/akramarenkov/cqos/v2/internal/general"
+	"github.com/akramarenkov/cqos/v2/priority/types"
+)
+
+var (
+	ErrFalseError = errors.New("false error")
+)
+
+const (
+	unmanagedCapacityDivider = 10
+)
+
+// Options of the created unmanaged discipline.
+type UnmanagedOpts[Type any] struct {
+	// Number of the data element, counted from one for each priority separately,
+	// upon receipt of which the discipline fails with the ErrFalseError error.
+	// A zero value means that the discipline does not fail
+	FailAt uint
+	// Quantity of handlers, used to choose the capacity of the output channel in
+	// the same way as in the prioritization discipline
+	HandlersQuantity uint
+	// Input data channels. Map key is a value of priority
+	Inputs map[uint]<-chan Type
+}
+
+// Discipline that does not manage data according to their priority: data elements
+// from all input channels are passed to the output channel as they arrive.
+//
+// Used as a baseline for comparison with the prioritization discipline.
+type Unmanaged[Type any] struct {
+	opts UnmanagedOpts[Type]
+
+	output chan types.Prioritized[Type]
+
+	err chan error
+}
+
+// Creates and runs unmanaged discipline.
+func NewUnmanaged[Type any](opts UnmanagedOpts[Type]) (*Unmanaged[Type], error) {
+	capacity := general.DivideWithMin(
+		opts.HandlersQuantity,
+		unmanagedCapacityDivider,
+		uint(len(opts.Inputs)),
+	)
+
+	dsc := &Unmanaged[Type]{
+		opts: opts,
+
+		output: make(chan types.Prioritized[Type], capacity),
+
+		err: make(chan error, 1),
+	}
+
+	go dsc.main()
+
+	return dsc, nil
+}
+
+// Returns output channel.
+//
+// If this channel is closed, it means that the discipline is terminated.
+func (dsc *Unmanaged[Type]) Output() <-chan types.Prioritized[Type] {
+	return dsc.output
+}
+
+// Does nothing, exists for compatibility with the prioritization discipline.
+func (dsc *Unmanaged[Type]) Release(uint) {
+}
+
+// Returns a channel with errors. If an error occurs, the discipline terminates
+// its work.
+func (dsc *Unmanaged[Type]) Err() <-chan error {
+	return dsc.err
+}
+
+func (dsc *Unmanaged[Type]) fail(err error) {
+	select {
+	case dsc.err <- err:
+	default:
+	}
+}
+
+func (dsc *Unmanaged[Type]) main() {
+	defer close(dsc.err)
+	defer close(dsc.output)
+
+	dsc.loop()
+}
+
+func (dsc *Unmanaged[Type]) loop() {
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+
+	for priority, input := range dsc.opts.Inputs {
+		wg.Add(1)
+
+		go dsc.io(wg, priority, input)
+	}
+}
+
+func (dsc *Unmanaged[Type]) io(wg *sync.WaitGroup, priority uint, input <-chan Type) {
+	defer wg.Done()
+
+	count := uint(0)
+
+	for item := range input {
+		count++
+
+		if count == dsc.opts.FailAt {
+			dsc.fail(ErrFalseError)
+			return
+		}
+
+		dsc.send(item, priority)
+	}
+}
+
+func (dsc *Unmanaged[Type]) send(item Type, priority uint) {
+	prioritized := types.Prioritized[Type]{
+		Priority: priority,
+		Item:     item,
+	}
+
+	dsc.output <- prioritized
+}
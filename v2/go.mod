@@ -1,6 +1,6 @@
 module github.com/akramarenkov/cqos/v2
 
-go 1.22.4
+go 1.23
 
 require (
 	github.com/akramarenkov/safe v0.2.3
@@ -11,6 +11,7 @@ require (
 	github.com/stretchr/testify v1.9.0
 	github.com/wcharczuk/go-chart/v2 v2.1.1
 	golang.org/x/exp v0.0.0-20240613232115-7f521ea00fb8
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -21,5 +22,4 @@ require (
 	github.com/kr/text v0.2.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/image v0.11.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
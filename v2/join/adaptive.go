@@ -0,0 +1,139 @@
+package join
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// Weight of a new sample in the exponentially weighted moving averages is
+	// calculated as 1/adaptiveSmoothing.
+	adaptiveSmoothing = 4
+	// Maximum quantity of the written slices awaiting acknowledgement. It bounds
+	// the memory consumption if the consumer does not acknowledge the slices.
+	maxEmissions = 1024
+)
+
+type emission struct {
+	at   time.Time
+	size int
+}
+
+// Estimates the size of the output slice at which the time from the receiving of
+// its first element to the end of its processing by the consumer is close to
+// the target latency.
+//
+// The latency of a slice of size n is estimated as n*(interval + hold), where
+// interval is the average interval between the receiving of the input elements
+// and hold is the average time that the consumer holds the slice per element.
+type adapter struct {
+	maxSize uint
+	minSize uint
+	target  time.Duration
+
+	mutex *sync.Mutex
+
+	emissions []emission
+	hold      average
+	interval  average
+}
+
+// Exponentially weighted moving average.
+type average struct {
+	known bool
+	value time.Duration
+}
+
+func (avg *average) add(sample time.Duration) {
+	if !avg.known {
+		avg.value = sample
+		avg.known = true
+
+		return
+	}
+
+	avg.value += (sample - avg.value) / adaptiveSmoothing
+}
+
+func newAdapter(minSize uint, maxSize uint, target time.Duration) *adapter {
+	adp := &adapter{
+		maxSize: maxSize,
+		minSize: minSize,
+		target:  target,
+
+		mutex: &sync.Mutex{},
+	}
+
+	return adp
+}
+
+// Registers the writing of the slice to the output channel.
+//
+// Interval between the receiving of the input elements is measured from the
+// receiving of the element preceding the slice, if it is known, so that it is
+// also measured for slices of one element.
+func (adp *adapter) emit(
+	at time.Time,
+	size int,
+	precedingAt time.Time,
+	firstAt time.Time,
+	lastAt time.Time,
+) {
+	adp.mutex.Lock()
+	defer adp.mutex.Unlock()
+
+	// Earliest slice is considered not acknowledged
+	if len(adp.emissions) == maxEmissions {
+		adp.emissions = adp.emissions[1:]
+	}
+
+	adp.emissions = append(adp.emissions, emission{at: at, size: size})
+
+	if !precedingAt.IsZero() {
+		adp.interval.add(lastAt.Sub(precedingAt) / time.Duration(size))
+		return
+	}
+
+	if size > 1 {
+		adp.interval.add(lastAt.Sub(firstAt) / time.Duration(size-1))
+	}
+}
+
+// Registers the end of processing by the consumer of the earliest written slice
+// for which it has not yet been registered.
+func (adp *adapter) ack(at time.Time) {
+	adp.mutex.Lock()
+	defer adp.mutex.Unlock()
+
+	if len(adp.emissions) == 0 {
+		return
+	}
+
+	earliest := adp.emissions[0]
+
+	adp.emissions = adp.emissions[1:]
+
+	adp.hold.add(at.Sub(earliest.at) / time.Duration(earliest.size))
+}
+
+// Returns the estimated size of the output slice.
+func (adp *adapter) size() uint {
+	adp.mutex.Lock()
+	defer adp.mutex.Unlock()
+
+	perItem := adp.interval.value + adp.hold.value
+
+	if perItem <= 0 {
+		return adp.maxSize
+	}
+
+	size := adp.target / perItem
+
+	// Conversion is safe because the size is not negative and is compared with
+	// the maximum size before conversion
+	if size >= time.Duration(adp.maxSize) {
+		return adp.maxSize
+	}
+
+	return max(uint(size), adp.minSize)
+}
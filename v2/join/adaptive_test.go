@@ -0,0 +1,97 @@
+package join
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdapter(t *testing.T) {
+	startedAt := time.Time{}
+
+	adp := newAdapter(2, 10, 100*time.Millisecond)
+
+	// Nothing is measured yet
+	require.Equal(t, uint(10), adp.size())
+
+	adp.ack(startedAt)
+	require.Equal(t, uint(10), adp.size())
+
+	// Interval between elements is 5ms
+	adp.emit(startedAt.Add(45*time.Millisecond), 10, time.Time{}, startedAt, startedAt.Add(45*time.Millisecond))
+	require.Equal(t, uint(10), adp.size())
+
+	// Consumer holds each element for 5ms
+	adp.ack(startedAt.Add(95 * time.Millisecond))
+	require.Equal(t, uint(10), adp.size())
+
+	// Consumer holds each element for 45ms: the average becomes 15ms
+	adp.emit(
+		startedAt.Add(time.Second),
+		10,
+		time.Time{},
+		startedAt.Add(955*time.Millisecond),
+		startedAt.Add(time.Second),
+	)
+	adp.ack(startedAt.Add(time.Second + 450*time.Millisecond))
+	require.Equal(t, uint(5), adp.size())
+
+	// Consumer holds each element for a long time, so the size is limited by
+	// the minimum
+	adp.emit(
+		startedAt.Add(2*time.Second),
+		1,
+		time.Time{},
+		startedAt.Add(2*time.Second),
+		startedAt.Add(2*time.Second),
+	)
+	adp.ack(startedAt.Add(3 * time.Second))
+	require.Equal(t, uint(2), adp.size())
+}
+
+func TestAdapterPreceding(t *testing.T) {
+	startedAt := time.Time{}.Add(time.Second)
+
+	adp := newAdapter(1, 10, 100*time.Millisecond)
+
+	// Interval between elements is 200ms
+	adp.emit(startedAt, 1, startedAt.Add(-200*time.Millisecond), startedAt, startedAt)
+	require.Equal(t, uint(1), adp.size())
+
+	// Interval is measured for slices of one element, so the size is recovered
+	// when elements are received frequently
+	for range 20 {
+		adp.emit(startedAt, 1, startedAt, startedAt, startedAt)
+	}
+
+	require.Equal(t, uint(10), adp.size())
+}
+
+func TestAdapterEmissions(t *testing.T) {
+	startedAt := time.Time{}
+
+	adp := newAdapter(1, 10, 100*time.Millisecond)
+
+	// Slices are not acknowledged
+	for id := range 2 * maxEmissions {
+		adp.emit(startedAt.Add(time.Duration(id)), 1, time.Time{}, startedAt, startedAt)
+	}
+
+	require.Len(t, adp.emissions, maxEmissions)
+	require.Equal(t, startedAt.Add(maxEmissions), adp.emissions[0].at)
+	require.Equal(t, uint(10), adp.size())
+}
+
+func TestAverage(t *testing.T) {
+	avg := average{}
+
+	avg.add(8)
+	require.Equal(t, time.Duration(8), avg.value)
+
+	avg.add(0)
+	require.Equal(t, time.Duration(6), avg.value)
+
+	avg.add(10)
+	require.Equal(t, time.Duration(7), avg.value)
+}
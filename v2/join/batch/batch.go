@@ -0,0 +1,62 @@
+// Output slice with metadata for all join packages.
+package batch
+
+import (
+	"time"
+)
+
+// Reason for writing the accumulated slice to the output channel.
+type Reason int
+
+const (
+	// Maximum size or maximum weight of the slice is reached.
+	ReasonFull Reason = iota + 1
+	// Timeout for slice accumulation has expired.
+	ReasonTimeout
+	// Flush() method is called.
+	ReasonFlush
+	// Input channel is closed.
+	ReasonClose
+	// Context specified in the options is completed.
+	ReasonCancel
+	// Boundary of the alignment window is reached.
+	ReasonWindow
+)
+
+func (rsn Reason) String() string {
+	switch rsn {
+	case ReasonFull:
+		return "full"
+	case ReasonTimeout:
+		return "timeout"
+	case ReasonFlush:
+		return "flush"
+	case ReasonClose:
+		return "close"
+	case ReasonCancel:
+		return "cancel"
+	case ReasonWindow:
+		return "window"
+	}
+
+	return "unknown"
+}
+
+// Accumulated slice together with the information about its accumulation.
+type Batch[Type any] struct {
+	// Time when the first data element of the slice was received from the input
+	// channel
+	FirstAt time.Time
+	// Accumulated slice
+	Items []Type
+	// Time when the last data element of the slice was received from the input
+	// channel
+	LastAt time.Time
+	// Reason for writing the slice to the output channel
+	Reason Reason
+	// Sequence number of the slice among the written ones, starting from zero
+	Sequence uint64
+	// Start time of the alignment window in which the slice was accumulated. It is
+	// zero if the alignment is not used
+	Window time.Time
+}
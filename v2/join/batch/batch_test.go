@@ -0,0 +1,17 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReasonString(t *testing.T) {
+	require.Equal(t, "full", ReasonFull.String())
+	require.Equal(t, "timeout", ReasonTimeout.String())
+	require.Equal(t, "flush", ReasonFlush.String())
+	require.Equal(t, "close", ReasonClose.String())
+	require.Equal(t, "cancel", ReasonCancel.String())
+	require.Equal(t, "window", ReasonWindow.String())
+	require.Equal(t, "unknown", Reason(0).String())
+}
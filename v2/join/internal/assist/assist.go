@@ -0,0 +1,38 @@
+// Internal helpers for all join packages.
+package assist
+
+import (
+	"context"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+)
+
+// Returns the channel of the timer or nil channel, receiving from which blocks
+// forever, if the timer is not used.
+func TimerC(timer clock.Timer) <-chan time.Time {
+	if timer == nil {
+		return nil
+	}
+
+	return timer.C()
+}
+
+// Waits for the Release() method call or for the context completion. Returns
+// false if waiting is interrupted by the context completion, in this case
+// the released data may still be used by the consumer, so it must not be reused.
+func WaitRelease(ctx context.Context, release <-chan struct{}) bool {
+	select {
+	case <-release:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Writes the value to the output channel. Writing is not interrupted by
+// the context completion, so the accumulated data is not lost at termination and
+// therefore the output channel must be read until it is closed.
+func Deliver[Type any](output chan<- Type, value Type) {
+	output <- value
+}
@@ -0,0 +1,47 @@
+package assist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimerC(t *testing.T) {
+	require.Nil(t, TimerC(nil))
+
+	manual := clock.NewManual(time.Time{})
+
+	timer := manual.NewTimer(time.Second)
+	defer timer.Stop()
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, time.Time{}.Add(time.Second), <-TimerC(timer))
+}
+
+func TestWaitRelease(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	release := make(chan struct{}, 1)
+
+	release <- struct{}{}
+
+	require.True(t, WaitRelease(ctx, release))
+
+	cancel()
+
+	require.False(t, WaitRelease(ctx, release))
+}
+
+func TestDeliver(t *testing.T) {
+	output := make(chan int, 1)
+
+	Deliver(output, 1)
+
+	require.Equal(t, 1, <-output)
+}
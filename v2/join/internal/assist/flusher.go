@@ -0,0 +1,57 @@
+package assist
+
+// Handshake of writing the accumulated data to the output channel on demand.
+//
+// The Flush() method is called by the consumer, the other methods are called by
+// the discipline goroutine.
+type Flusher struct {
+	flushed  chan struct{}
+	requests chan chan struct{}
+}
+
+// Creates handshake.
+func NewFlusher() *Flusher {
+	flr := &Flusher{
+		requests: make(chan chan struct{}),
+	}
+
+	return flr
+}
+
+// Requests writing of the accumulated data to the output channel and waits for
+// it to be completed. Returns immediately if the done channel is closed, that is,
+// if the discipline is terminated.
+func (flr *Flusher) Flush(done <-chan struct{}) {
+	flushed := make(chan struct{})
+
+	select {
+	case flr.requests <- flushed:
+	case <-done:
+		return
+	}
+
+	<-flushed
+}
+
+// Returns the channel of requests. Received request must be passed to
+// the Accept() method.
+func (flr *Flusher) Requests() <-chan chan struct{} {
+	return flr.requests
+}
+
+// Remembers the received request until the Notify() method call.
+func (flr *Flusher) Accept(flushed chan struct{}) {
+	flr.flushed = flushed
+}
+
+// Notifies the requester, if any, that the accumulated data has been written to
+// the output channel or that there is nothing to write.
+func (flr *Flusher) Notify() {
+	if flr.flushed == nil {
+		return
+	}
+
+	close(flr.flushed)
+
+	flr.flushed = nil
+}
@@ -0,0 +1,35 @@
+package assist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlusher(t *testing.T) {
+	flr := NewFlusher()
+
+	// Nothing is requested
+	flr.Notify()
+
+	go func() {
+		flushed := <-flr.Requests()
+
+		flr.Accept(flushed)
+		flr.Notify()
+		flr.Notify()
+	}()
+
+	flr.Flush(nil)
+}
+
+func TestFlusherDone(t *testing.T) {
+	flr := NewFlusher()
+
+	done := make(chan struct{})
+	close(done)
+
+	flr.Flush(done)
+
+	require.NotNil(t, flr.Requests())
+}
@@ -0,0 +1,95 @@
+package assist
+
+import (
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+)
+
+// Timer of the timeout for accumulation.
+//
+// By default, the timeout is measured from the last writing to the output
+// channel. While nothing is accumulated, the time of the last writing is
+// considered to advance by the timeout, so the timer expires at the nearest point
+// of this grid. If the timeout is measured from the first element, the timer
+// expires exactly after the timeout from its start.
+type Timeout struct {
+	clock     clock.Clock
+	fromFirst bool
+	timeout   time.Duration
+
+	active bool
+	passAt time.Time
+	timer  clock.Timer
+}
+
+// Creates timer of the timeout for accumulation.
+//
+// Timer is created stopped and is started only when the accumulated data becomes
+// non-empty, so there are no interruptions while nothing is accumulated. If
+// the timeout is zero or negative, the timer is not created and its channel
+// blocks forever.
+func NewTimeout(clk clock.Clock, timeout time.Duration, fromFirst bool) *Timeout {
+	tmt := &Timeout{
+		clock:     clk,
+		fromFirst: fromFirst,
+		timeout:   timeout,
+
+		passAt: clk.Now(),
+	}
+
+	if timeout > 0 {
+		tmt.timer = clk.NewTimer(timeout)
+		tmt.timer.Stop()
+	}
+
+	return tmt
+}
+
+// Returns the channel of the timer or nil channel, receiving from which blocks
+// forever, if the timer is not created. Receiving from the channel must be
+// registered by the Expired() method call.
+func (tmt *Timeout) C() <-chan time.Time {
+	return TimerC(tmt.timer)
+}
+
+// Registers the expiration of the timer.
+func (tmt *Timeout) Expired() {
+	tmt.active = false
+}
+
+// Starts the timer, if it is not already started. Must be called when
+// the accumulated data is non-empty.
+func (tmt *Timeout) Start() {
+	if tmt.timer == nil || tmt.active {
+		return
+	}
+
+	tmt.active = true
+
+	if tmt.fromFirst {
+		tmt.timer.Reset(tmt.timeout)
+		return
+	}
+
+	elapsed := tmt.clock.Since(tmt.passAt) % tmt.timeout
+
+	tmt.timer.Reset(tmt.timeout - elapsed)
+}
+
+// Registers the writing to the output channel: the timeout is measured from this
+// moment and the timer is stopped.
+func (tmt *Timeout) Pass() {
+	tmt.passAt = tmt.clock.Now()
+	tmt.Stop()
+}
+
+// Stops the timer.
+func (tmt *Timeout) Stop() {
+	if !tmt.active {
+		return
+	}
+
+	tmt.timer.Stop()
+	tmt.active = false
+}
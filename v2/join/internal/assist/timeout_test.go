@@ -0,0 +1,70 @@
+package assist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeout(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	tmt := NewTimeout(manual, time.Second, false)
+	defer tmt.Stop()
+
+	// Timer is started at the nearest point of the grid
+	manual.Advance(2500 * time.Millisecond)
+	tmt.Start()
+	tmt.Start()
+
+	manual.Advance(499 * time.Millisecond)
+	require.Empty(t, tmt.C())
+
+	manual.Advance(time.Millisecond)
+	require.Equal(t, time.Time{}.Add(3*time.Second), <-tmt.C())
+
+	tmt.Expired()
+
+	// Timeout is measured from the writing to the output channel
+	manual.Advance(200 * time.Millisecond)
+	tmt.Pass()
+	tmt.Start()
+
+	manual.Advance(999 * time.Millisecond)
+	require.Empty(t, tmt.C())
+
+	manual.Advance(time.Millisecond)
+	require.Equal(t, time.Time{}.Add(4200*time.Millisecond), <-tmt.C())
+}
+
+func TestTimeoutFromFirst(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	tmt := NewTimeout(manual, time.Second, true)
+	defer tmt.Stop()
+
+	manual.Advance(2500 * time.Millisecond)
+	tmt.Start()
+
+	manual.Advance(999 * time.Millisecond)
+	require.Empty(t, tmt.C())
+
+	// Stopped timer does not expire
+	tmt.Pass()
+
+	manual.Advance(time.Second)
+	require.Empty(t, tmt.C())
+}
+
+func TestTimeoutDisabled(t *testing.T) {
+	tmt := NewTimeout(clock.NewManual(time.Time{}), 0, false)
+
+	tmt.Start()
+	tmt.Pass()
+	tmt.Stop()
+
+	require.Nil(t, tmt.C())
+}
@@ -1,4 +0,0 @@
-// Internal package used to generate sequence of numbers in the form of blocks and
-// predictions of how they will be processed by disciplines. Used for
-// testing disciplines.
-package inspect
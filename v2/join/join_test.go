@@ -1,11 +1,15 @@
 package join
 
 import (
+	"context"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/akramarenkov/cqos/v2/clock"
+	"github.com/akramarenkov/cqos/v2/cqostest"
+	"github.com/akramarenkov/cqos/v2/join/batch"
 	"github.com/akramarenkov/cqos/v2/join/internal/defs"
-	"github.com/akramarenkov/cqos/v2/join/internal/inspect"
 
 	"github.com/stretchr/testify/require"
 )
@@ -50,6 +54,73 @@ func TestOptsValidation(t *testing.T) {
 
 	_, err = New(opts)
 	require.NoError(t, err)
+
+	opts = Opts[int]{
+		Input:    make(chan int),
+		JoinSize: 10,
+		Weight:   func(int) uint { return 1 },
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrMaxWeightZero)
+
+	opts = Opts[int]{
+		Input:     make(chan int),
+		JoinSize:  10,
+		MaxWeight: 10,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrWeightEmpty)
+
+	opts = Opts[int]{
+		Input:     make(chan int),
+		JoinSize:  10,
+		MaxWeight: 10,
+		Weight:    func(int) uint { return 1 },
+	}
+
+	_, err = New(opts)
+	require.NoError(t, err)
+
+	opts = Opts[int]{
+		Input:    make(chan int),
+		JoinSize: 10,
+		PoolSize: 2,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrPoolSizeWithoutNoCopy)
+
+	opts = Opts[int]{
+		Input:    make(chan int),
+		JoinSize: 10,
+		NoCopy:   true,
+		PoolSize: 2,
+	}
+
+	_, err = New(opts)
+	require.NoError(t, err)
+
+	opts = Opts[int]{
+		Input:       make(chan int),
+		JoinSize:    10,
+		MinJoinSize: 11,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrMinJoinSizeTooBig)
+
+	opts = Opts[int]{
+		Input:         make(chan int),
+		JoinSize:      10,
+		NoCopy:        true,
+		PoolSize:      2,
+		TargetLatency: time.Second,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrPoolSizeWithLatency)
 }
 
 func TestDiscipline(t *testing.T) {
@@ -93,13 +164,13 @@ func testDiscipline(
 	inSequence := make([]int, 0, quantity)
 	outSequence := make([]int, 0, quantity)
 
-	expected := inspect.Expected(quantity, 1, joinSize)
+	expected := cqostest.Expected(quantity, 1, joinSize)
 	output := make([][]int, 0, len(expected))
 
 	go func() {
 		defer close(input)
 
-		for _, block := range inspect.Input(quantity, 1) {
+		for _, block := range cqostest.Input(quantity, 1) {
 			for _, item := range block {
 				inSequence = append(inSequence, item)
 
@@ -189,7 +260,7 @@ func testDisciplineTimeout(
 
 	require.NotZero(t, timeout)
 
-	pauseAt = inspect.PickUpPauseAt(quantity, pauseAt, 1, joinSize)
+	pauseAt = cqostest.PickUpPauseAt(quantity, pauseAt, 1, joinSize)
 	require.NotZero(
 		t,
 		pauseAt,
@@ -201,7 +272,7 @@ func testDisciplineTimeout(
 		pauseAt,
 	)
 
-	pausetAtDuration := inspect.CalcPauseAtDuration(timeout)
+	pausetAtDuration := cqostest.CalcPauseAtDuration(timeout)
 
 	discipline, err := New(opts)
 	require.NoError(
@@ -218,13 +289,13 @@ func testDisciplineTimeout(
 	inSequence := make([]int, 0, quantity)
 	outSequence := make([]int, 0, quantity)
 
-	expected := inspect.ExpectedWithTimeout(quantity, pauseAt, 1, joinSize)
+	expected := cqostest.ExpectedWithTimeout(quantity, pauseAt, 1, joinSize)
 	output := make([][]int, 0, len(expected))
 
 	go func() {
 		defer close(input)
 
-		for _, block := range inspect.Input(quantity, 1) {
+		for _, block := range cqostest.Input(quantity, 1) {
 			for _, item := range block {
 				if item == pauseAt {
 					time.Sleep(pausetAtDuration)
@@ -282,68 +353,853 @@ func testDisciplineTimeout(
 	)
 }
 
+func TestDisciplineClock(t *testing.T) {
+	testDisciplineClock(t, false)
+	testDisciplineClock(t, true)
+}
+
+func testDisciplineClock(t *testing.T, noCopy bool) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Clock:    manual,
+		Input:    input,
+		JoinSize: 3,
+		NoCopy:   noCopy,
+		Timeout:  time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+
+	// Waiting for the timer to be started by the first accumulated element
+	manual.BlockUntil(1)
+
+	input <- 2
+
+	manual.Advance(time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, []int{1, 2}, <-discipline.Output())
+
+	// Timer is not started while nothing is accumulated
+	require.Equal(t, 0, manual.Blockers())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- 3
+	input <- 4
+	input <- 5
+
+	require.Equal(t, []int{3, 4, 5}, <-discipline.Output())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- 6
+
+	close(input)
+
+	require.Equal(t, []int{6}, <-discipline.Output())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineTimeoutFromFirst(t *testing.T) {
+	testDisciplineTimeoutFromFirst(t, false)
+	testDisciplineTimeoutFromFirst(t, true)
+}
+
+func testDisciplineTimeoutFromFirst(t *testing.T, fromFirst bool) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Clock:            manual,
+		Input:            input,
+		JoinSize:         3,
+		Timeout:          time.Second,
+		TimeoutFromFirst: fromFirst,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	manual.Advance(time.Second / 2)
+
+	input <- 1
+
+	manual.BlockUntil(1)
+
+	// By default, the timeout is measured from the creation of the discipline,
+	// that is, from the last writing to the output channel
+	if !fromFirst {
+		manual.Advance(time.Second / 2)
+		require.Equal(t, []int{1}, <-discipline.Output())
+
+		close(input)
+
+		_, opened := <-discipline.Output()
+		require.False(t, opened)
+
+		return
+	}
+
+	manual.Advance(time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, []int{1}, <-discipline.Output())
+
+	close(input)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineAdaptive(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Clock:         manual,
+		Input:         input,
+		JoinSize:      10,
+		MinJoinSize:   2,
+		NoCopy:        true,
+		TargetLatency: 100 * time.Millisecond,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		for item := range 1000 {
+			input <- item
+		}
+	}()
+
+	// Consumer holds the first slice for 50ms per element, so the size is
+	// decreased. Then it releases slices immediately, so the size is gradually
+	// increased up to the maximum
+	expected := []int{10, 2, 2, 3, 4, 6, 8, 10}
+	sizes := make([]int, 0, len(expected))
+
+	for join := range discipline.Output() {
+		if len(sizes) == 0 {
+			manual.Advance(500 * time.Millisecond)
+		}
+
+		if len(sizes) < len(expected) {
+			sizes = append(sizes, len(join))
+		}
+
+		discipline.Release()
+	}
+
+	require.Equal(t, expected, sizes)
+}
+
+func TestDisciplineAdaptiveAck(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Clock:         manual,
+		Input:         input,
+		JoinSize:      10,
+		MinJoinSize:   2,
+		TargetLatency: 100 * time.Millisecond,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	for item := range 10 {
+		input <- item
+	}
+
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, <-discipline.Output())
+
+	manual.Advance(500 * time.Millisecond)
+
+	// Element is received only after the size is adapted for the first slice, so
+	// the acknowledgement affects the size of the third slice
+	input <- 10
+
+	// Consumer holds the first slice for 50ms per element
+	discipline.Ack()
+
+	for item := 11; item < 20; item++ {
+		input <- item
+	}
+
+	require.Equal(t, []int{10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, <-discipline.Output())
+
+	discipline.Ack()
+
+	input <- 20
+	input <- 21
+
+	require.Equal(t, []int{20, 21}, <-discipline.Output())
+
+	discipline.Ack()
+
+	close(input)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineAdaptiveWithoutAck(t *testing.T) {
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Input:         input,
+		JoinSize:      1,
+		TargetLatency: time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		for item := range 2 * maxEmissions {
+			input <- item
+		}
+	}()
+
+	for join := range discipline.Output() {
+		require.Len(t, join, 1)
+	}
+
+	require.Len(t, discipline.adapter.emissions, maxEmissions)
+}
+
+func TestDisciplineAdaptiveRecovery(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Clock:         manual,
+		Input:         input,
+		JoinSize:      10,
+		TargetLatency: 100 * time.Millisecond,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	// Elements are received slowly, so the size is decreased to the minimum
+	for item := range 10 {
+		if item != 0 {
+			manual.Advance(200 * time.Millisecond)
+		}
+
+		input <- item
+	}
+
+	require.Len(t, <-discipline.Output(), 10)
+
+	go func() {
+		defer close(input)
+
+		for item := range 1000 {
+			input <- item
+		}
+	}()
+
+	// Then elements are received without delays, so the size is increased up to
+	// the maximum despite the slices of one element
+	sizes := make([]int, 0)
+
+	for join := range discipline.Output() {
+		sizes = append(sizes, len(join))
+	}
+
+	require.Equal(t, 1, sizes[0])
+	require.Contains(t, sizes, 10)
+	require.Less(t, len(sizes), 200)
+}
+
+func TestDisciplineFlush(t *testing.T) {
+	testDisciplineFlush(t, false, 0)
+	testDisciplineFlush(t, true, 0)
+	testDisciplineFlush(t, false, defs.TestTimeout)
+	testDisciplineFlush(t, true, defs.TestTimeout)
+}
+
+func testDisciplineFlush(t *testing.T, noCopy bool, timeout time.Duration) {
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Input:    input,
+		JoinSize: 5,
+		NoCopy:   noCopy,
+		Timeout:  timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	// Nothing has been accumulated
+	discipline.Flush()
+	require.Empty(t, discipline.Output())
+
+	input <- 1
+	input <- 2
+
+	discipline.Flush()
+	require.Len(t, discipline.Output(), 1)
+	require.Equal(t, []int{1, 2}, <-discipline.Output())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- 3
+
+	close(input)
+
+	require.Equal(t, []int{3}, <-discipline.Output())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	// Discipline is terminated
+	discipline.Flush()
+	discipline.Release()
+}
+
+func TestDisciplineContext(t *testing.T) {
+	testDisciplineContext(t, false, 0)
+	testDisciplineContext(t, true, 0)
+	testDisciplineContext(t, false, defs.TestTimeout)
+	testDisciplineContext(t, true, defs.TestTimeout)
+}
+
+func testDisciplineContext(t *testing.T, noCopy bool, timeout time.Duration) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Context:  ctx,
+		Input:    input,
+		JoinSize: 2,
+		NoCopy:   noCopy,
+		Timeout:  timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+	input <- 2
+
+	// In NoCopy mode the discipline is blocked on waiting for the Release() method
+	// call. Otherwise, output channel has a capacity of one, so the discipline is
+	// blocked on writing the next slice
+	if !noCopy {
+		input <- 3
+		input <- 4
+	}
+
+	cancel()
+
+	require.Equal(t, []int{1, 2}, <-discipline.Output())
+
+	// Writing to the output channel is not interrupted, so the slice accumulated
+	// at the time of cancellation is delivered
+	if !noCopy {
+		require.Equal(t, []int{3, 4}, <-discipline.Output())
+	}
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	// Discipline is terminated
+	discipline.Release()
+	discipline.Flush()
+}
+
+func TestDisciplineContextAccumulated(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Context:  ctx,
+		Input:    input,
+		JoinSize: 5,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+	input <- 2
+
+	cancel()
+
+	// Accumulated slice is written at termination
+	require.Equal(t, []int{1, 2}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineWeight(t *testing.T) {
+	testDisciplineWeight(t, false, 0)
+	testDisciplineWeight(t, true, 0)
+	testDisciplineWeight(t, false, defs.TestTimeout)
+	testDisciplineWeight(t, true, defs.TestTimeout)
+}
+
+func testDisciplineWeight(t *testing.T, noCopy bool, timeout time.Duration) {
+	sequence := []int{1, 2, 3, 4, 5, 6, 12, 2, 8, 10, 0, 1}
+
+	expected := [][]int{
+		{1, 2, 3}, // join size is reached
+		{4, 5},    // next element would exceed the maximum weight
+		{6},       // next element is over-weight
+		{12},      // over-weight element goes alone
+		{2, 8},    // maximum weight is reached
+		{10},      // element with the maximum weight goes alone
+		{0, 1},    // input channel is closed
+	}
+
+	input := make(chan int, len(sequence))
+
+	opts := Opts[int]{
+		Input:     input,
+		JoinSize:  3,
+		MaxWeight: 10,
+		NoCopy:    noCopy,
+		Timeout:   timeout,
+		Weight: func(item int) uint {
+			return uint(item)
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	for _, item := range sequence {
+		input <- item
+	}
+
+	close(input)
+
+	output := make([][]int, 0, len(expected))
+
+	for join := range discipline.Output() {
+		output = append(output, slices.Clone(join))
+
+		if noCopy {
+			discipline.Release()
+		}
+	}
+
+	require.Equal(t, expected, output)
+}
+
+func TestDisciplinePool(t *testing.T) {
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Input:    input,
+		JoinSize: 2,
+		NoCopy:   true,
+		PoolSize: 2,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+	input <- 2
+	first := <-discipline.Output()
+	require.Equal(t, []int{1, 2}, first)
+
+	// Discipline does not wait for the first slice to be released
+	input <- 3
+	input <- 4
+	second := <-discipline.Output()
+	require.Equal(t, []int{3, 4}, second)
+
+	// All buffers of the pool are in use
+	select {
+	case input <- 5:
+		require.FailNow(t, "discipline does not wait for a buffer to be released")
+	default:
+	}
+
+	discipline.Recycle(first)
+
+	input <- 5
+	input <- 6
+	third := <-discipline.Output()
+	require.Equal(t, []int{5, 6}, third)
+
+	// Released buffer is reused
+	require.Equal(t, []int{5, 6}, first)
+
+	discipline.Recycle(second)
+	discipline.Recycle(third)
+
+	close(input)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	// Discipline is terminated
+	discipline.Recycle(first)
+}
+
+func TestDisciplineAlign(t *testing.T) {
+	startedAt := time.Time{}
+
+	manual := clock.NewManual(startedAt)
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		AlignInterval: 10 * time.Second,
+		AlignOffset:   2 * time.Second,
+		Clock:         manual,
+		Input:         input,
+		JoinSize:      2,
+		Metadata:      true,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	manual.Advance(5 * time.Second)
+
+	input <- 1
+
+	// Waiting for the alignment timer to be started by the first element
+	manual.BlockUntil(1)
+
+	manual.Advance(7*time.Second - 1)
+	require.Empty(t, discipline.Batches())
+
+	// Window is closed at the boundary regardless of the last writing
+	manual.Advance(1)
+
+	expected := batch.Batch[int]{
+		FirstAt:  startedAt.Add(5 * time.Second),
+		Items:    []int{1},
+		LastAt:   startedAt.Add(5 * time.Second),
+		Reason:   batch.ReasonWindow,
+		Sequence: 0,
+		Window:   startedAt.Add(2 * time.Second),
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	manual.Advance(time.Second)
+
+	input <- 2
+	input <- 3
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(13 * time.Second),
+		Items:    []int{2, 3},
+		LastAt:   startedAt.Add(13 * time.Second),
+		Reason:   batch.ReasonFull,
+		Sequence: 1,
+		Window:   startedAt.Add(12 * time.Second),
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	input <- 4
+
+	manual.BlockUntil(1)
+	manual.Advance(9 * time.Second)
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(13 * time.Second),
+		Items:    []int{4},
+		LastAt:   startedAt.Add(13 * time.Second),
+		Reason:   batch.ReasonWindow,
+		Sequence: 2,
+		Window:   startedAt.Add(12 * time.Second),
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	input <- 5
+
+	close(input)
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(22 * time.Second),
+		Items:    []int{5},
+		LastAt:   startedAt.Add(22 * time.Second),
+		Reason:   batch.ReasonClose,
+		Sequence: 3,
+		Window:   startedAt.Add(22 * time.Second),
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	_, opened := <-discipline.Batches()
+	require.False(t, opened)
+
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineMetadata(t *testing.T) {
+	testDisciplineMetadata(t, false)
+	testDisciplineMetadata(t, true)
+}
+
+func testDisciplineMetadata(t *testing.T, noCopy bool) {
+	startedAt := time.Time{}
+
+	manual := clock.NewManual(startedAt)
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Clock:    manual,
+		Input:    input,
+		JoinSize: 3,
+		Metadata: true,
+		NoCopy:   noCopy,
+		Timeout:  time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+
+	// Waiting for the first element to be accumulated
+	manual.BlockUntil(1)
+	manual.Advance(time.Second / 2)
+
+	input <- 2
+
+	discipline.Flush()
+
+	expected := batch.Batch[int]{
+		FirstAt:  startedAt,
+		Items:    []int{1, 2},
+		LastAt:   startedAt.Add(time.Second / 2),
+		Reason:   batch.ReasonFlush,
+		Sequence: 0,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- 3
+
+	manual.BlockUntil(1)
+	manual.Advance(time.Second)
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(time.Second / 2),
+		Items:    []int{3},
+		LastAt:   startedAt.Add(time.Second / 2),
+		Reason:   batch.ReasonTimeout,
+		Sequence: 1,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- 4
+	input <- 5
+	input <- 6
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(3 * time.Second / 2),
+		Items:    []int{4, 5, 6},
+		LastAt:   startedAt.Add(3 * time.Second / 2),
+		Reason:   batch.ReasonFull,
+		Sequence: 2,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- 7
+
+	close(input)
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(3 * time.Second / 2),
+		Items:    []int{7},
+		LastAt:   startedAt.Add(3 * time.Second / 2),
+		Reason:   batch.ReasonClose,
+		Sequence: 3,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	_, opened := <-discipline.Batches()
+	require.False(t, opened)
+
+	_, opened = <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineMetadataCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	startedAt := time.Time{}
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Clock:    clock.NewManual(startedAt),
+		Context:  ctx,
+		Input:    input,
+		JoinSize: 3,
+		Metadata: true,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+
+	cancel()
+
+	// Returns when the discipline is terminated
+	discipline.Flush()
+
+	expected := batch.Batch[int]{
+		FirstAt:  startedAt,
+		Items:    []int{1},
+		LastAt:   startedAt,
+		Reason:   batch.ReasonCancel,
+		Sequence: 0,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	_, opened := <-discipline.Batches()
+	require.False(t, opened)
+}
+
 func BenchmarkDiscipline(b *testing.B) {
-	benchmarkDiscipline(b, 10, false, defs.TestTimeout, 1)
+	benchmarkDiscipline(b, 10, false, defs.TestTimeout, 1, 0)
 }
 
 func BenchmarkDisciplineNoCopy(b *testing.B) {
-	benchmarkDiscipline(b, 10, true, defs.TestTimeout, 1)
+	benchmarkDiscipline(b, 10, true, defs.TestTimeout, 1, 0)
 }
 
 func BenchmarkDisciplineUntimeouted(b *testing.B) {
-	benchmarkDiscipline(b, 10, false, 0, 1)
+	benchmarkDiscipline(b, 10, false, 0, 1, 0)
 }
 
 func BenchmarkDisciplineNoCopyUntimeouted(b *testing.B) {
-	benchmarkDiscipline(b, 10, true, 0, 1)
+	benchmarkDiscipline(b, 10, true, 0, 1, 0)
+}
+
+func BenchmarkDisciplinePool(b *testing.B) {
+	benchmarkDiscipline(b, 10, true, defs.TestTimeout, 1, 4)
+}
+
+func BenchmarkDisciplinePoolUntimeouted(b *testing.B) {
+	benchmarkDiscipline(b, 10, true, 0, 1, 4)
 }
 
 func BenchmarkDisciplineInputCapacity0(b *testing.B) {
-	benchmarkDiscipline(b, 10, false, 0, 0)
+	benchmarkDiscipline(b, 10, false, 0, 0, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity0(b *testing.B) {
-	benchmarkDiscipline(b, 10, true, 0, 0)
+	benchmarkDiscipline(b, 10, true, 0, 0, 0)
 }
 
 func BenchmarkDisciplineInputCapacity50(b *testing.B) {
-	benchmarkDiscipline(b, 10, false, 0, 0.5)
+	benchmarkDiscipline(b, 10, false, 0, 0.5, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity50(b *testing.B) {
-	benchmarkDiscipline(b, 10, true, 0, 0.5)
+	benchmarkDiscipline(b, 10, true, 0, 0.5, 0)
 }
 
 func BenchmarkDisciplineInputCapacity100(b *testing.B) {
-	benchmarkDiscipline(b, 10, false, 0, 1)
+	benchmarkDiscipline(b, 10, false, 0, 1, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity100(b *testing.B) {
-	benchmarkDiscipline(b, 10, true, 0, 1)
+	benchmarkDiscipline(b, 10, true, 0, 1, 0)
 }
 
 func BenchmarkDisciplineInputCapacity200(b *testing.B) {
-	benchmarkDiscipline(b, 10, false, 0, 2)
+	benchmarkDiscipline(b, 10, false, 0, 2, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity200(b *testing.B) {
-	benchmarkDiscipline(b, 10, true, 0, 2)
+	benchmarkDiscipline(b, 10, true, 0, 2, 0)
 }
 
 func BenchmarkDisciplineInputCapacity300(b *testing.B) {
-	benchmarkDiscipline(b, 10, false, 0, 3)
+	benchmarkDiscipline(b, 10, false, 0, 3, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity300(b *testing.B) {
-	benchmarkDiscipline(b, 10, true, 0, 3)
+	benchmarkDiscipline(b, 10, true, 0, 3, 0)
 }
 
 func BenchmarkDisciplineInputCapacity400(b *testing.B) {
-	benchmarkDiscipline(b, 10, false, 0, 4)
+	benchmarkDiscipline(b, 10, false, 0, 4, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity400(b *testing.B) {
-	benchmarkDiscipline(b, 10, true, 0, 4)
+	benchmarkDiscipline(b, 10, true, 0, 4, 0)
 }
 
 func benchmarkDiscipline(
@@ -352,6 +1208,7 @@ func benchmarkDiscipline(
 	noCopy bool,
 	timeout time.Duration,
 	inputCapFactor float64,
+	poolSize uint,
 ) {
 	joinsQuantity := b.N
 	quantity := joinsQuantity * int(joinSize)
@@ -363,6 +1220,7 @@ func benchmarkDiscipline(
 		Input:    input,
 		JoinSize: joinSize,
 		NoCopy:   noCopy,
+		PoolSize: poolSize,
 		Timeout:  timeout,
 	}
 
@@ -379,7 +1237,12 @@ func benchmarkDiscipline(
 		}
 	}()
 
-	for range discipline.Output() {
+	for join := range discipline.Output() {
+		if poolSize != 0 {
+			discipline.Recycle(join)
+			continue
+		}
+
 		if noCopy {
 			discipline.Release()
 		}
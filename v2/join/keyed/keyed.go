@@ -0,0 +1,411 @@
+// Discipline used to accumulate elements from an input channel into separate
+// slices by their keys and write each slice to an output channel when
+// the maximum slice size or timeout for its accumulation is reached. It works
+// like a join discipline but accumulates a slice for each key independently.
+package keyed
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+	"github.com/akramarenkov/cqos/v2/join/internal/assist"
+)
+
+var (
+	ErrInputEmpty   = errors.New("input channel was not specified")
+	ErrJoinSizeZero = errors.New("join size is zero")
+	ErrKeyEmpty     = errors.New("key function was not specified")
+)
+
+// Options of the created discipline.
+type Opts[Key comparable, Type any] struct {
+	// Source of the current time and timers. By default, the wall clock is used.
+	// Can be replaced with the clock.Manual for deterministic testing
+	Clock clock.Clock
+	// Returns the maximum size of the output slice and the timeout for slice
+	// accumulation of a key. It is called once when the key is opened, so
+	// the settings may differ for different keys, for example, for tables with
+	// different insertion costs. A zero join size means that the JoinSize is used,
+	// a zero timeout means that the Timeout is used and a negative timeout means
+	// that the slice of the key is not limited by time. By default, the JoinSize
+	// and the Timeout are used for all keys
+	Config func(key Key) (joinSize uint, timeout time.Duration)
+	// Context whose completion terminates the discipline without waiting for
+	// the input channel to be closed. At termination, the accumulated slices are
+	// written to the output channel, so no data is lost, and therefore the output
+	// channel must be read until it is closed. By default, the discipline is
+	// terminated only by closing the input channel
+	Context context.Context
+	// Input data channel. For terminate discipline it is necessary and sufficient to
+	// close the input channel
+	Input <-chan Type
+	// Maximum size of the output slice of each key. Actual size of the output slice
+	// may be smaller due to the timeout, limit of the quantity of open keys or
+	// closure of the input channel
+	JoinSize uint
+	// Returns the key of an element, for example, the name of the table into which
+	// it is to be inserted
+	Key func(Type) Key
+	// Maximum quantity of keys for which slices are accumulated at the same time.
+	// If an element with a new key is received when the maximum is reached, then
+	// the slice of the key that was opened earliest is written to the output
+	// channel. A zero value means that the quantity of keys is not limited
+	MaxKeys uint
+	// Timeout for slice accumulation, measured for each key from the receiving of
+	// the first element of its slice. If the slice has not been filled completely
+	// in the allotted time, the data accumulated during this time is written to
+	// the output channel. A zero or negative value means that discipline will wait
+	// for the missing data until they appear or the channel is closed
+	Timeout time.Duration
+}
+
+func (opts Opts[Key, Type]) isValid() error {
+	if opts.Input == nil {
+		return ErrInputEmpty
+	}
+
+	if opts.JoinSize == 0 {
+		return ErrJoinSizeZero
+	}
+
+	if opts.Key == nil {
+		return ErrKeyEmpty
+	}
+
+	return nil
+}
+
+func (opts Opts[Key, Type]) normalize() Opts[Key, Type] {
+	if opts.Clock == nil {
+		opts.Clock = clock.Real{}
+	}
+
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+
+	return opts
+}
+
+// Accumulated slice of elements with the same key.
+type Batch[Key comparable, Type any] struct {
+	Items []Type
+	Key   Key
+}
+
+type accumulator[Key comparable, Type any] struct {
+	items    []Type
+	joinSize uint
+	key      Key
+	// Zero value means that the slice is not limited by time
+	expiresAt time.Time
+
+	// Neighbors in the list of accumulators in the order of opening
+	newer *accumulator[Key, Type]
+	older *accumulator[Key, Type]
+}
+
+// Keyed join discipline.
+type Discipline[Key comparable, Type any] struct {
+	opts Opts[Key, Type]
+
+	accumulators map[Key]*accumulator[Key, Type]
+	done         chan struct{}
+	// Accumulator whose timeout expires earliest
+	expiring *accumulator[Key, Type]
+	flusher  *assist.Flusher
+	// Ends of the list of accumulators in the order of opening, that is, also in
+	// the order of the timeout expiration
+	newest      *accumulator[Key, Type]
+	oldest      *accumulator[Key, Type]
+	output      chan Batch[Key, Type]
+	timer       clock.Timer
+	timerActive bool
+	timerFor    *accumulator[Key, Type]
+}
+
+// Creates and runs discipline.
+func New[Key comparable, Type any](opts Opts[Key, Type]) (*Discipline[Key, Type], error) {
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	opts = opts.normalize()
+
+	dsc := &Discipline[Key, Type]{
+		opts: opts,
+
+		accumulators: make(map[Key]*accumulator[Key, Type]),
+		done:         make(chan struct{}),
+		flusher:      assist.NewFlusher(),
+		// Value returned by the cap() function is always positive and, in the case of
+		// integer overflow due to adding one, the resulting value can only become
+		// negative, which will cause a panic when executing make() as same as when
+		// specifying a large positive value
+		output: make(chan Batch[Key, Type], 1+cap(opts.Input)),
+	}
+
+	go dsc.main()
+
+	return dsc, nil
+}
+
+// Returns output channel.
+//
+// The slices written to the output channel are not used by the discipline
+// anymore, so they do not need to be released.
+//
+// If this channel is closed, it means that the discipline is terminated.
+func (dsc *Discipline[Key, Type]) Output() <-chan Batch[Key, Type] {
+	return dsc.output
+}
+
+// Writes the accumulated slices of all keys to the output channel without waiting
+// for the JoinSize to be reached or the timeout to expire and without closing
+// the input channel.
+//
+// Returns after the slices have been written to the output channel and also if
+// the discipline is terminated.
+func (dsc *Discipline[Key, Type]) Flush() {
+	dsc.flusher.Flush(dsc.done)
+}
+
+func (dsc *Discipline[Key, Type]) main() {
+	defer close(dsc.done)
+	defer close(dsc.output)
+
+	if dsc.opts.Timeout > 0 || dsc.opts.Config != nil {
+		// Timer is created stopped and is started only when there are accumulated
+		// slices, so there are no interruptions while nothing is accumulated
+		dsc.timer = dsc.opts.Clock.NewTimer(dsc.opts.Timeout)
+		dsc.timer.Stop()
+
+		defer dsc.timer.Stop()
+	}
+
+	dsc.loop()
+}
+
+func (dsc *Discipline[Key, Type]) loop() {
+	for {
+		select {
+		case <-dsc.opts.Context.Done():
+			dsc.passAll()
+			return
+		case flushed := <-dsc.flusher.Requests():
+			dsc.flusher.Accept(flushed)
+			dsc.passAll()
+			dsc.flusher.Notify()
+		case <-assist.TimerC(dsc.timer):
+			dsc.timerActive = false
+			dsc.passExpired()
+		case item, opened := <-dsc.opts.Input:
+			if !opened {
+				dsc.passAll()
+				return
+			}
+
+			dsc.process(item)
+		}
+
+		dsc.startTimer()
+	}
+}
+
+func (dsc *Discipline[Key, Type]) process(item Type) {
+	key := dsc.opts.Key(item)
+
+	acc, exists := dsc.accumulators[key]
+	if !exists {
+		acc = dsc.open(key)
+	}
+
+	acc.items = append(acc.items, item)
+
+	// Integer overflow is impossible because len() function returns only positive
+	// values ​​for type int and the maximum value for type int is less than the
+	// maximum value for type uint
+	if uint(len(acc.items)) < acc.joinSize {
+		return
+	}
+
+	dsc.pass(acc)
+}
+
+func (dsc *Discipline[Key, Type]) open(key Key) *accumulator[Key, Type] {
+	// Integer overflow is impossible because len() function returns only positive
+	// values ​​for type int and the maximum value for type int is less than the
+	// maximum value for type uint
+	if dsc.opts.MaxKeys != 0 && uint(len(dsc.accumulators)) >= dsc.opts.MaxKeys {
+		dsc.pass(dsc.oldest)
+	}
+
+	joinSize, timeout := dsc.configure(key)
+
+	acc := &accumulator[Key, Type]{
+		items:    make([]Type, 0, joinSize),
+		joinSize: joinSize,
+		key:      key,
+	}
+
+	if timeout > 0 {
+		acc.expiresAt = dsc.opts.Clock.Now().Add(timeout)
+	}
+
+	dsc.link(acc)
+
+	return acc
+}
+
+func (dsc *Discipline[Key, Type]) configure(key Key) (uint, time.Duration) {
+	if dsc.opts.Config == nil {
+		return dsc.opts.JoinSize, dsc.opts.Timeout
+	}
+
+	joinSize, timeout := dsc.opts.Config(key)
+
+	if joinSize == 0 {
+		joinSize = dsc.opts.JoinSize
+	}
+
+	if timeout == 0 {
+		timeout = dsc.opts.Timeout
+	}
+
+	return joinSize, timeout
+}
+
+// Adds the accumulator to the newest end of the list.
+func (dsc *Discipline[Key, Type]) link(acc *accumulator[Key, Type]) {
+	dsc.accumulators[acc.key] = acc
+
+	acc.older = dsc.newest
+
+	if dsc.newest != nil {
+		dsc.newest.newer = acc
+	}
+
+	dsc.newest = acc
+
+	if dsc.oldest == nil {
+		dsc.oldest = acc
+	}
+
+	if acc.expiresAt.IsZero() {
+		return
+	}
+
+	if dsc.expiring == nil || acc.expiresAt.Before(dsc.expiring.expiresAt) {
+		dsc.expiring = acc
+	}
+}
+
+// Removes the accumulator from the list.
+func (dsc *Discipline[Key, Type]) unlink(acc *accumulator[Key, Type]) {
+	delete(dsc.accumulators, acc.key)
+
+	if acc.older != nil {
+		acc.older.newer = acc.newer
+	} else {
+		dsc.oldest = acc.newer
+	}
+
+	if acc.newer != nil {
+		acc.newer.older = acc.older
+	} else {
+		dsc.newest = acc.older
+	}
+
+	acc.newer = nil
+	acc.older = nil
+
+	if acc == dsc.expiring {
+		dsc.expiring = dsc.findExpiring()
+	}
+}
+
+// Without the Config function, all keys have the same timeout, so the timeout of
+// the earliest opened accumulator expires earliest. Otherwise, all accumulators
+// are viewed.
+func (dsc *Discipline[Key, Type]) findExpiring() *accumulator[Key, Type] {
+	if dsc.opts.Config == nil {
+		if dsc.opts.Timeout <= 0 {
+			return nil
+		}
+
+		return dsc.oldest
+	}
+
+	var expiring *accumulator[Key, Type]
+
+	for acc := dsc.oldest; acc != nil; acc = acc.newer {
+		if acc.expiresAt.IsZero() {
+			continue
+		}
+
+		if expiring == nil || acc.expiresAt.Before(expiring.expiresAt) {
+			expiring = acc
+		}
+	}
+
+	return expiring
+}
+
+func (dsc *Discipline[Key, Type]) passExpired() {
+	now := dsc.opts.Clock.Now()
+
+	for dsc.expiring != nil && !dsc.expiring.expiresAt.After(now) {
+		dsc.pass(dsc.expiring)
+	}
+}
+
+func (dsc *Discipline[Key, Type]) passAll() {
+	for dsc.oldest != nil {
+		dsc.pass(dsc.oldest)
+	}
+}
+
+// Writes the accumulated slice to the output channel and closes the accumulator.
+func (dsc *Discipline[Key, Type]) pass(acc *accumulator[Key, Type]) {
+	dsc.unlink(acc)
+
+	batch := Batch[Key, Type]{
+		Items: acc.items,
+		Key:   acc.key,
+	}
+
+	assist.Deliver(dsc.output, batch)
+}
+
+// Starts the timer for the accumulator whose timeout expires earliest, if it is
+// not already started for it.
+func (dsc *Discipline[Key, Type]) startTimer() {
+	if dsc.timer == nil {
+		return
+	}
+
+	if dsc.expiring == nil {
+		dsc.stopTimer()
+		return
+	}
+
+	if dsc.timerActive && dsc.timerFor == dsc.expiring {
+		return
+	}
+
+	dsc.timer.Reset(dsc.expiring.expiresAt.Sub(dsc.opts.Clock.Now()))
+	dsc.timerActive = true
+	dsc.timerFor = dsc.expiring
+}
+
+func (dsc *Discipline[Key, Type]) stopTimer() {
+	if !dsc.timerActive {
+		return
+	}
+
+	dsc.timer.Stop()
+	dsc.timerActive = false
+	dsc.timerFor = nil
+}
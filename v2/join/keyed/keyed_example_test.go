@@ -0,0 +1,56 @@
+package keyed_test
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/join/keyed"
+)
+
+func ExampleDiscipline() {
+	data := []string{
+		"users:1",
+		"orders:1",
+		"users:2",
+		"orders:2",
+		"orders:3",
+		"users:3",
+	}
+
+	input := make(chan string, 2)
+
+	opts := keyed.Opts[string, string]{
+		Input:    input,
+		JoinSize: 2,
+		Key: func(item string) string {
+			table, _, _ := strings.Cut(item, ":")
+			return table
+		},
+		MaxKeys: 10,
+		Timeout: time.Second,
+	}
+
+	discipline, err := keyed.New(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		defer close(input)
+
+		for _, item := range data {
+			input <- item
+		}
+	}()
+
+	for batch := range discipline.Output() {
+		fmt.Println(batch.Key, batch.Items)
+	}
+
+	// Output:
+	// users [users:1 users:2]
+	// orders [orders:1 orders:2]
+	// orders [orders:3]
+	// users [users:3]
+}
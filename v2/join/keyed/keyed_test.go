@@ -0,0 +1,304 @@
+package keyed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptsValidation(t *testing.T) {
+	key := func(item int) int { return item }
+
+	opts := Opts[int, int]{
+		JoinSize: 10,
+		Key:      key,
+	}
+
+	_, err := New(opts)
+	require.ErrorIs(t, err, ErrInputEmpty)
+
+	opts = Opts[int, int]{
+		Input: make(chan int),
+		Key:   key,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrJoinSizeZero)
+
+	opts = Opts[int, int]{
+		Input:    make(chan int),
+		JoinSize: 10,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrKeyEmpty)
+
+	opts = Opts[int, int]{
+		Input:    make(chan int),
+		JoinSize: 10,
+		Key:      key,
+	}
+
+	_, err = New(opts)
+	require.NoError(t, err)
+}
+
+func TestDiscipline(t *testing.T) {
+	for joinSize := uint(1); joinSize <= 10; joinSize++ {
+		for maxKeys := uint(0); maxKeys <= 5; maxKeys++ {
+			testDiscipline(t, 1000, 7, joinSize, maxKeys)
+		}
+	}
+}
+
+func testDiscipline(t *testing.T, quantity int, keysQuantity int, joinSize uint, maxKeys uint) {
+	input := make(chan int, joinSize)
+
+	opts := Opts[int, int]{
+		Input:    input,
+		JoinSize: joinSize,
+		Key:      func(item int) int { return item % keysQuantity },
+		MaxKeys:  maxKeys,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		for item := range quantity {
+			input <- item
+		}
+	}()
+
+	received := make(map[int][]int)
+
+	for batch := range discipline.Output() {
+		require.NotEmpty(t, batch.Items)
+		require.LessOrEqual(t, len(batch.Items), int(joinSize))
+
+		for _, item := range batch.Items {
+			require.Equal(t, batch.Key, item%keysQuantity)
+		}
+
+		received[batch.Key] = append(received[batch.Key], batch.Items...)
+	}
+
+	expected := make(map[int][]int)
+
+	for item := range quantity {
+		expected[item%keysQuantity] = append(expected[item%keysQuantity], item)
+	}
+
+	require.Equal(t, expected, received, "join size: %v, max keys: %v", joinSize, maxKeys)
+}
+
+func TestDisciplineClock(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	opts := Opts[int, int]{
+		Clock:    manual,
+		Input:    input,
+		JoinSize: 3,
+		Key:      func(item int) int { return item / 10 },
+		MaxKeys:  2,
+		Timeout:  time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 11
+
+	// Waiting for the timer to be started by the first accumulated element
+	manual.BlockUntil(1)
+
+	input <- 21
+	input <- 12
+
+	// Maximum quantity of keys is reached, so the earliest opened key is written
+	input <- 31
+
+	require.Equal(t, Batch[int, int]{Items: []int{11, 12}, Key: 1}, <-discipline.Output())
+
+	input <- 22
+	input <- 23
+
+	require.Equal(t, Batch[int, int]{Items: []int{21, 22, 23}, Key: 2}, <-discipline.Output())
+
+	manual.Advance(time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, Batch[int, int]{Items: []int{31}, Key: 3}, <-discipline.Output())
+
+	input <- 41
+
+	manual.BlockUntil(1)
+	manual.Advance(time.Second / 2)
+
+	input <- 51
+
+	// Timeout is measured from the first element of each key
+	manual.Advance(time.Second / 2)
+	require.Equal(t, Batch[int, int]{Items: []int{41}, Key: 4}, <-discipline.Output())
+
+	input <- 52
+
+	discipline.Flush()
+	require.Equal(t, Batch[int, int]{Items: []int{51, 52}, Key: 5}, <-discipline.Output())
+
+	input <- 61
+
+	close(input)
+
+	require.Equal(t, Batch[int, int]{Items: []int{61}, Key: 6}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineConfig(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	config := func(key int) (uint, time.Duration) {
+		if key == 1 {
+			return 2, 3 * time.Second
+		}
+
+		return 0, 0
+	}
+
+	opts := Opts[int, int]{
+		Clock:    manual,
+		Config:   config,
+		Input:    input,
+		JoinSize: 3,
+		Key:      func(item int) int { return item / 10 },
+		Timeout:  time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 11
+	input <- 21
+
+	// Join size of the first key is reduced
+	input <- 12
+	require.Equal(t, Batch[int, int]{Items: []int{11, 12}, Key: 1}, <-discipline.Output())
+
+	input <- 13
+	input <- 22
+	input <- 23
+	require.Equal(t, Batch[int, int]{Items: []int{21, 22, 23}, Key: 2}, <-discipline.Output())
+
+	input <- 24
+
+	// Element is received only after the timer is restarted for the second key
+	input <- 31
+
+	// Timeouts of the second and third keys expire earlier, although their slices
+	// are opened later
+	manual.Advance(time.Second)
+	require.Equal(t, Batch[int, int]{Items: []int{24}, Key: 2}, <-discipline.Output())
+	require.Equal(t, Batch[int, int]{Items: []int{31}, Key: 3}, <-discipline.Output())
+
+	// Waiting for the timer to be restarted for the first key
+	manual.BlockUntil(1)
+	manual.Advance(2*time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, Batch[int, int]{Items: []int{13}, Key: 1}, <-discipline.Output())
+
+	close(input)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan int)
+
+	opts := Opts[int, int]{
+		Context:  ctx,
+		Input:    input,
+		JoinSize: 3,
+		Key:      func(item int) int { return item / 10 },
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 11
+	input <- 21
+
+	cancel()
+
+	// Writing to the output channel is not interrupted, so the slices of all keys
+	// are delivered
+	require.Equal(t, Batch[int, int]{Items: []int{11}, Key: 1}, <-discipline.Output())
+	require.Equal(t, Batch[int, int]{Items: []int{21}, Key: 2}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func BenchmarkDiscipline(b *testing.B) {
+	benchmarkDiscipline(b, 0)
+}
+
+func BenchmarkDisciplineTimeouted(b *testing.B) {
+	benchmarkDiscipline(b, time.Minute)
+}
+
+func benchmarkDiscipline(b *testing.B, timeout time.Duration) {
+	const (
+		joinSize     = 10
+		keysQuantity = 16
+	)
+
+	input := make(chan int, joinSize)
+
+	opts := Opts[int, int]{
+		Input:    input,
+		JoinSize: joinSize,
+		Key:      func(item int) int { return item % keysQuantity },
+		MaxKeys:  keysQuantity,
+		Timeout:  timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	go func() {
+		defer close(input)
+
+		for item := range b.N * joinSize {
+			input <- item
+		}
+	}()
+
+	for batch := range discipline.Output() {
+		require.NotEmpty(b, batch.Items)
+	}
+}
@@ -0,0 +1,448 @@
+// Discipline used to accumulate elements from several input channels into a slice
+// and write that slice to an output channel when the maximum slice size or timeout
+// for its accumulation is reached. It works like a join discipline but reads
+// the input channels in turn, optionally according to their weights, so that
+// a single input channel with a large quantity of elements does not fill
+// the entire slice.
+package merge
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+	"github.com/akramarenkov/cqos/v2/join/internal/assist"
+)
+
+var (
+	ErrInputEmpty      = errors.New("input channel was not specified")
+	ErrJoinSizeZero    = errors.New("join size is zero")
+	ErrWeightZero      = errors.New("weight is zero")
+	ErrWeightsQuantity = errors.New("quantity of weights is not equal to quantity of inputs")
+)
+
+// Indices of the cases of the blocking selection that precede the cases of
+// the input channels.
+const (
+	caseContext = iota
+	caseFlush
+	caseTimer
+	casesQuantity
+)
+
+// Options of the created discipline.
+type Opts[Type any] struct {
+	// Source of the current time and timers. By default, the wall clock is used.
+	// Can be replaced with the clock.Manual for deterministic testing
+	Clock clock.Clock
+	// Context whose completion terminates the discipline without waiting for
+	// the input channels to be closed. At termination, the accumulated slice is
+	// written to the output channel, so no data is lost, and therefore the output
+	// channel must be read until it is closed. Waiting for the Release() method
+	// call is interrupted. By default, the discipline is terminated only by closing
+	// all the input channels
+	Context context.Context
+	// Input data channels. For terminate discipline it is necessary and sufficient
+	// to close all the input channels. As with the join discipline, preferably
+	// input channels should be buffered for performance reasons, the capacity of
+	// the output channel is chosen based on their total capacity
+	Inputs []<-chan Type
+	// Maximum size of the output slice. Actual size of the output slice may be
+	// smaller due to the timeout or closure of the input channels
+	JoinSize uint
+	// By default, to the output channel is written a copy of the accumulated slice
+	// If the NoCopy is set to true, then to the output channel will be directly
+	// written the accumulated slice. In this case, after the accumulated slice is
+	// no longer used it is necessary to inform the discipline about it by calling
+	// Release() method
+	NoCopy bool
+	// Timeout for slice accumulation. If the slice has not been filled completely
+	// in the allotted time, the data accumulated during this time is written to
+	// the output channel. A zero or negative value means that discipline will wait
+	// for the missing data until they appear or the channels are closed (in this
+	// case, the accumulated data will be written to the output channel)
+	Timeout time.Duration
+	// Weights of the input channels in the same order as the input channels. While
+	// several input channels have data, the discipline reads from each of them in
+	// turn the quantity of elements equal to its weight. By default, all weights
+	// are equal to one, that is, the input channels are read in turn one element
+	// at a time
+	Weights []uint
+}
+
+func (opts Opts[Type]) isValid() error {
+	if len(opts.Inputs) == 0 {
+		return ErrInputEmpty
+	}
+
+	for _, input := range opts.Inputs {
+		if input == nil {
+			return ErrInputEmpty
+		}
+	}
+
+	if opts.JoinSize == 0 {
+		return ErrJoinSizeZero
+	}
+
+	if opts.Weights != nil && len(opts.Weights) != len(opts.Inputs) {
+		return ErrWeightsQuantity
+	}
+
+	for _, weight := range opts.Weights {
+		if weight == 0 {
+			return ErrWeightZero
+		}
+	}
+
+	return nil
+}
+
+func (opts Opts[Type]) normalize() Opts[Type] {
+	if opts.Clock == nil {
+		opts.Clock = clock.Real{}
+	}
+
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+
+	return opts
+}
+
+// Multi-input join discipline.
+type Discipline[Type any] struct {
+	opts Opts[Type]
+
+	// Cases of the blocking selection, the cases of the closed input channels
+	// are disabled
+	cases []reflect.SelectCase
+	// Quantity of elements that can still be read from the current input channel
+	// before moving to the next one
+	credit  uint
+	current int
+	done    chan struct{}
+	flusher *assist.Flusher
+	join    []Type
+	opened  int
+	output  chan []Type
+	release chan struct{}
+	timeout *assist.Timeout
+}
+
+// Creates and runs discipline.
+func New[Type any](opts Opts[Type]) (*Discipline[Type], error) {
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	opts = opts.normalize()
+
+	dsc := &Discipline[Type]{
+		opts: opts,
+
+		done:    make(chan struct{}),
+		flusher: assist.NewFlusher(),
+		join:    make([]Type, 0, opts.JoinSize),
+		opened:  len(opts.Inputs),
+		output:  make(chan []Type, calcOutputCapacity(opts.Inputs)),
+		release: make(chan struct{}),
+		timeout: assist.NewTimeout(opts.Clock, opts.Timeout, false),
+	}
+
+	dsc.credit = dsc.weight(0)
+
+	go dsc.main()
+
+	return dsc, nil
+}
+
+// Output channel has the same capacity as in the join discipline with an input
+// channel whose capacity is equal to the total capacity of the input channels.
+func calcOutputCapacity[Type any](inputs []<-chan Type) int {
+	capacity := 1
+
+	// Value returned by the cap() function is always positive and, in the case of
+	// integer overflow due to adding, the resulting value can only become negative,
+	// which will cause a panic when executing make() as same as when specifying
+	// a large positive value
+	for _, input := range inputs {
+		capacity += cap(input)
+	}
+
+	return capacity
+}
+
+// Returns output channel.
+//
+// If this channel is closed, it means that the discipline is terminated.
+func (dsc *Discipline[Type]) Output() <-chan []Type {
+	return dsc.output
+}
+
+// Marks accumulated slice as no longer used.
+//
+// Must be used only if NoCopy option is set to true.
+func (dsc *Discipline[Type]) Release() {
+	select {
+	case dsc.release <- struct{}{}:
+	case <-dsc.done:
+	}
+}
+
+// Writes the accumulated slice to the output channel without waiting for
+// the JoinSize to be reached or the timeout to expire and without closing
+// the input channels.
+//
+// Returns after the slice has been written to the output channel, immediately if
+// nothing has been accumulated, and also if the discipline is terminated. Does
+// not wait for the Release() method call.
+func (dsc *Discipline[Type]) Flush() {
+	dsc.flusher.Flush(dsc.done)
+}
+
+func (dsc *Discipline[Type]) main() {
+	defer close(dsc.done)
+	defer close(dsc.output)
+	defer dsc.timeout.Stop()
+
+	dsc.prepareCases()
+	dsc.loop()
+}
+
+func (dsc *Discipline[Type]) prepareCases() {
+	dsc.cases = make([]reflect.SelectCase, casesQuantity+len(dsc.opts.Inputs))
+
+	dsc.cases[caseContext] = reflect.SelectCase{
+		Chan: reflect.ValueOf(dsc.opts.Context.Done()),
+		Dir:  reflect.SelectRecv,
+	}
+
+	dsc.cases[caseFlush] = reflect.SelectCase{
+		Chan: reflect.ValueOf(dsc.flusher.Requests()),
+		Dir:  reflect.SelectRecv,
+	}
+
+	// Case with the zero channel value is ignored by the selection
+	dsc.cases[caseTimer] = reflect.SelectCase{
+		Dir: reflect.SelectRecv,
+	}
+
+	if timer := dsc.timeout.C(); timer != nil {
+		dsc.cases[caseTimer].Chan = reflect.ValueOf(timer)
+	}
+
+	for id, input := range dsc.opts.Inputs {
+		dsc.cases[casesQuantity+id] = reflect.SelectCase{
+			Chan: reflect.ValueOf(input),
+			Dir:  reflect.SelectRecv,
+		}
+	}
+}
+
+func (dsc *Discipline[Type]) loop() {
+	for {
+		// Events are checked before each receiving from the input channels, so
+		// they are not delayed while the input channels have data
+		if !dsc.handleEvents() {
+			return
+		}
+
+		if dsc.receive() {
+			continue
+		}
+
+		if !dsc.wait() {
+			return
+		}
+	}
+}
+
+// Handles the events that have already occurred. Returns false if the discipline
+// should be terminated.
+func (dsc *Discipline[Type]) handleEvents() bool {
+	select {
+	case <-dsc.opts.Context.Done():
+		dsc.pass()
+		return false
+	case flushed := <-dsc.flusher.Requests():
+		dsc.flusher.Accept(flushed)
+		dsc.pass()
+	case <-dsc.timeout.C():
+		dsc.timeout.Expired()
+		dsc.pass()
+	default:
+	}
+
+	return true
+}
+
+// Receives a data element from the input channels without blocking, starting
+// from the current input channel. Returns false if all the input channels are
+// empty.
+func (dsc *Discipline[Type]) receive() bool {
+	for range dsc.opts.Inputs {
+		if dsc.opened == 0 {
+			return false
+		}
+
+		if dsc.isOpened(dsc.current) {
+			select {
+			case item, opened := <-dsc.opts.Inputs[dsc.current]:
+				if !opened {
+					dsc.closeInput(dsc.current)
+					dsc.next()
+
+					continue
+				}
+
+				dsc.credit--
+
+				if dsc.credit == 0 {
+					dsc.next()
+				}
+
+				dsc.process(item)
+
+				return true
+			default:
+			}
+		}
+
+		dsc.next()
+	}
+
+	return false
+}
+
+// Waits for an event or a data element from any input channel. Returns false if
+// the discipline should be terminated.
+func (dsc *Discipline[Type]) wait() bool {
+	if dsc.opened == 0 {
+		dsc.pass()
+		return false
+	}
+
+	chosen, value, opened := reflect.Select(dsc.cases)
+
+	switch chosen {
+	case caseContext:
+		dsc.pass()
+		return false
+	case caseFlush:
+		// Conversion is safe because only values of this type are written to
+		// the flush channel
+		flushed, _ := value.Interface().(chan struct{})
+
+		dsc.flusher.Accept(flushed)
+		dsc.pass()
+	case caseTimer:
+		dsc.timeout.Expired()
+		dsc.pass()
+	default:
+		id := chosen - casesQuantity
+
+		if !opened {
+			dsc.closeInput(id)
+			return true
+		}
+
+		// Received element is counted as read in the turn of its input channel
+		dsc.current = id
+		dsc.credit = dsc.weight(id) - 1
+
+		if dsc.credit == 0 {
+			dsc.next()
+		}
+
+		// Conversion is safe because only values of this type are received from
+		// the input channels
+		item, _ := value.Interface().(Type)
+
+		dsc.process(item)
+	}
+
+	return true
+}
+
+func (dsc *Discipline[Type]) isOpened(id int) bool {
+	return dsc.cases[casesQuantity+id].Chan.IsValid()
+}
+
+func (dsc *Discipline[Type]) closeInput(id int) {
+	dsc.cases[casesQuantity+id].Chan = reflect.Value{}
+	dsc.opened--
+}
+
+// Moves to the next input channel.
+func (dsc *Discipline[Type]) next() {
+	dsc.current = (dsc.current + 1) % len(dsc.opts.Inputs)
+	dsc.credit = dsc.weight(dsc.current)
+}
+
+func (dsc *Discipline[Type]) weight(id int) uint {
+	if dsc.opts.Weights == nil {
+		return 1
+	}
+
+	return dsc.opts.Weights[id]
+}
+
+func (dsc *Discipline[Type]) process(item Type) {
+	dsc.join = append(dsc.join, item)
+
+	// Integer overflow is impossible because len() function returns only positive
+	// values ​​for type int and the maximum value for type int is less than the
+	// maximum value for type uint
+	if uint(len(dsc.join)) < dsc.opts.JoinSize {
+		dsc.timeout.Start()
+		return
+	}
+
+	dsc.pass()
+}
+
+func (dsc *Discipline[Type]) pass() {
+	if len(dsc.join) == 0 {
+		// defer statement is not used to allow inlining of the current function
+		dsc.timeout.Pass()
+		dsc.flusher.Notify()
+
+		return
+	}
+
+	dsc.send(dsc.join)
+	dsc.resetJoin()
+	dsc.timeout.Pass()
+}
+
+func (dsc *Discipline[Type]) send(item []Type) {
+	item = dsc.prepareItem(item)
+
+	assist.Deliver(dsc.output, item)
+
+	dsc.flusher.Notify()
+
+	if !dsc.opts.NoCopy {
+		return
+	}
+
+	if !assist.WaitRelease(dsc.opts.Context, dsc.release) {
+		dsc.join = nil
+	}
+}
+
+func (dsc *Discipline[Type]) prepareItem(item []Type) []Type {
+	if dsc.opts.NoCopy {
+		return item
+	}
+
+	return slices.Clone(item)
+}
+
+func (dsc *Discipline[Type]) resetJoin() {
+	dsc.join = dsc.join[:0]
+}
@@ -0,0 +1,45 @@
+package merge_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/join/merge"
+)
+
+func ExampleDiscipline() {
+	chatty := make(chan int, 20)
+	quiet := make(chan int, 5)
+
+	for item := range cap(chatty) {
+		chatty <- 100 + item
+	}
+
+	for item := range cap(quiet) {
+		quiet <- 200 + item
+	}
+
+	close(chatty)
+	close(quiet)
+
+	opts := merge.Opts[int]{
+		Inputs:   []<-chan int{chatty, quiet},
+		JoinSize: 10,
+		Timeout:  time.Second,
+		Weights:  []uint{2, 1},
+	}
+
+	discipline, err := merge.New(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	for join := range discipline.Output() {
+		fmt.Println(join)
+	}
+
+	// Output:
+	// [100 101 200 102 103 201 104 105 202 106]
+	// [107 203 108 109 204 110 111 112 113 114]
+	// [115 116 117 118 119]
+}
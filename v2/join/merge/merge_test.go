@@ -0,0 +1,345 @@
+package merge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptsValidation(t *testing.T) {
+	opts := Opts[int]{
+		JoinSize: 10,
+	}
+
+	_, err := New(opts)
+	require.ErrorIs(t, err, ErrInputEmpty)
+
+	opts = Opts[int]{
+		Inputs:   []<-chan int{make(chan int), nil},
+		JoinSize: 10,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrInputEmpty)
+
+	opts = Opts[int]{
+		Inputs: []<-chan int{make(chan int)},
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrJoinSizeZero)
+
+	opts = Opts[int]{
+		Inputs:   []<-chan int{make(chan int), make(chan int)},
+		JoinSize: 10,
+		Weights:  []uint{1},
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrWeightsQuantity)
+
+	opts = Opts[int]{
+		Inputs:   []<-chan int{make(chan int), make(chan int)},
+		JoinSize: 10,
+		Weights:  []uint{1, 0},
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrWeightZero)
+
+	opts = Opts[int]{
+		Inputs:   []<-chan int{make(chan int), make(chan int)},
+		JoinSize: 10,
+		Weights:  []uint{1, 2},
+	}
+
+	_, err = New(opts)
+	require.NoError(t, err)
+}
+
+func TestDiscipline(t *testing.T) {
+	for inputsQuantity := 1; inputsQuantity <= 5; inputsQuantity++ {
+		for joinSize := uint(1); joinSize <= 10; joinSize++ {
+			testDiscipline(t, 1000, inputsQuantity, joinSize, false, 0)
+			testDiscipline(t, 1000, inputsQuantity, joinSize, true, 0)
+			testDiscipline(t, 1000, inputsQuantity, joinSize, false, time.Minute)
+			testDiscipline(t, 1000, inputsQuantity, joinSize, true, time.Minute)
+		}
+	}
+}
+
+func testDiscipline(
+	t *testing.T,
+	quantity int,
+	inputsQuantity int,
+	joinSize uint,
+	noCopy bool,
+	timeout time.Duration,
+) {
+	inputs := make([]<-chan int, inputsQuantity)
+	weights := make([]uint, inputsQuantity)
+
+	for id := range inputsQuantity {
+		input := make(chan int, id)
+
+		inputs[id] = input
+		weights[id] = uint(id + 1)
+
+		// Input channels are closed at different times
+		go func() {
+			defer close(input)
+
+			for item := range quantity * (id + 1) {
+				input <- item*inputsQuantity + id
+			}
+		}()
+	}
+
+	opts := Opts[int]{
+		Inputs:   inputs,
+		JoinSize: joinSize,
+		NoCopy:   noCopy,
+		Timeout:  timeout,
+		Weights:  weights,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	received := make([][]int, inputsQuantity)
+
+	for join := range discipline.Output() {
+		require.NotEmpty(t, join)
+		require.LessOrEqual(t, len(join), int(joinSize))
+
+		for _, item := range join {
+			received[item%inputsQuantity] = append(received[item%inputsQuantity], item)
+		}
+
+		if noCopy {
+			discipline.Release()
+		}
+	}
+
+	for id := range inputsQuantity {
+		expected := make([]int, 0, quantity*(id+1))
+
+		for item := range quantity * (id + 1) {
+			expected = append(expected, item*inputsQuantity+id)
+		}
+
+		require.Equal(
+			t,
+			expected,
+			received[id],
+			"inputs quantity: %v, join size: %v, no copy: %v, timeout: %v",
+			inputsQuantity,
+			joinSize,
+			noCopy,
+			timeout,
+		)
+	}
+}
+
+func TestDisciplineFair(t *testing.T) {
+	testDisciplineFair(t, nil, []int{10, 20, 11, 21, 12, 22, 13, 23})
+	testDisciplineFair(t, []uint{3, 1}, []int{10, 11, 12, 20, 13, 14, 15, 21})
+	testDisciplineFair(t, []uint{1, 2}, []int{10, 20, 21, 11, 22, 23, 12, 24})
+}
+
+func testDisciplineFair(t *testing.T, weights []uint, expected []int) {
+	chatty := make(chan int, 100)
+	quiet := make(chan int, 10)
+
+	for item := range cap(chatty) {
+		chatty <- 10 + item
+	}
+
+	for item := range cap(quiet) {
+		quiet <- 20 + item
+	}
+
+	close(chatty)
+	close(quiet)
+
+	opts := Opts[int]{
+		Inputs:   []<-chan int{chatty, quiet},
+		JoinSize: uint(len(expected)),
+		Weights:  weights,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	// Input channels are read in turn while both have data
+	require.Equal(t, expected, <-discipline.Output(), "weights: %v", weights)
+
+	quantity := len(expected)
+
+	for join := range discipline.Output() {
+		quantity += len(join)
+	}
+
+	require.Equal(t, cap(chatty)+cap(quiet), quantity)
+}
+
+func TestDisciplineClock(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	first := make(chan int)
+	second := make(chan int)
+
+	opts := Opts[int]{
+		Clock:    manual,
+		Inputs:   []<-chan int{first, second},
+		JoinSize: 3,
+		Timeout:  time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	first <- 1
+
+	// Waiting for the timer to be started by the first accumulated element
+	manual.BlockUntil(1)
+
+	second <- 2
+
+	manual.Advance(time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, []int{1, 2}, <-discipline.Output())
+
+	second <- 3
+
+	discipline.Flush()
+	require.Equal(t, []int{3}, <-discipline.Output())
+
+	// Nothing is accumulated, so nothing is written
+	discipline.Flush()
+	require.Empty(t, discipline.Output())
+
+	close(first)
+
+	second <- 4
+
+	close(second)
+
+	require.Equal(t, []int{4}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	first := make(chan int)
+	second := make(chan int)
+
+	opts := Opts[int]{
+		Context:  ctx,
+		Inputs:   []<-chan int{first, second},
+		JoinSize: 3,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	first <- 1
+	second <- 2
+	first <- 3
+
+	// Output channel has a capacity of one, so the discipline is blocked on
+	// writing of the second slice
+	first <- 4
+	second <- 5
+	first <- 6
+
+	cancel()
+
+	// Discipline is terminated without closing the input channels and writing to
+	// the output channel is not interrupted
+	require.Equal(t, []int{1, 2, 3}, <-discipline.Output())
+	require.Equal(t, []int{4, 5, 6}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineOutputCapacity(t *testing.T) {
+	first := make(chan int)
+	second := make(chan int, 2)
+	third := make(chan int, 3)
+
+	opts := Opts[int]{
+		Inputs:   []<-chan int{first, second, third},
+		JoinSize: 3,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+	require.Equal(t, 6, cap(discipline.Output()))
+
+	close(first)
+	close(second)
+	close(third)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func BenchmarkDiscipline(b *testing.B) {
+	benchmarkDiscipline(b, 0)
+}
+
+func BenchmarkDisciplineTimeouted(b *testing.B) {
+	benchmarkDiscipline(b, time.Minute)
+}
+
+func benchmarkDiscipline(b *testing.B, timeout time.Duration) {
+	const (
+		inputsQuantity = 4
+		joinSize       = 10
+	)
+
+	inputs := make([]<-chan int, inputsQuantity)
+
+	for id := range inputsQuantity {
+		input := make(chan int, joinSize)
+
+		inputs[id] = input
+
+		go func() {
+			defer close(input)
+
+			for item := range b.N * joinSize / inputsQuantity {
+				input <- item
+			}
+		}()
+	}
+
+	opts := Opts[int]{
+		Inputs:   inputs,
+		JoinSize: joinSize,
+		Timeout:  timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	for join := range discipline.Output() {
+		require.NotEmpty(b, join)
+	}
+}
@@ -0,0 +1,291 @@
+// Discipline used to split slices received from an input channel into slices of
+// the exact size and write them to an output channel. Elements of the input slices
+// that do not fill the output slice completely are accumulated and written to
+// the output channel together with the elements of the following input slices or
+// when the timeout for their accumulation is reached. It is the inverse of
+// the unite discipline.
+package rechunk
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+	"github.com/akramarenkov/cqos/v2/join/internal/assist"
+)
+
+var (
+	ErrChunkSizeZero = errors.New("chunk size is zero")
+	ErrInputEmpty    = errors.New("input channel was not specified")
+)
+
+// Options of the created discipline.
+type Opts[Type any] struct {
+	// Size of the output slice. Only the last output slice, written due to
+	// the timeout, Flush() method call or closure of the input channel, may be
+	// smaller
+	ChunkSize uint
+	// Source of the current time and timers. By default, the wall clock is used.
+	// Can be replaced with the clock.Manual for deterministic testing
+	Clock clock.Clock
+	// Context whose completion terminates the discipline without waiting for
+	// the input channel to be closed. At termination, the accumulated slice is
+	// written to the output channel, so no data is lost, and therefore the output
+	// channel must be read until it is closed. Waiting for the Release() method
+	// call is interrupted. By default, the discipline is terminated only by closing
+	// the input channel
+	Context context.Context
+	// Input data channel. For terminate discipline it is necessary and sufficient to
+	// close the input channel
+	Input <-chan []Type
+	// By default, to the output channel is written a copy of the output slice
+	// If the NoCopy is set to true, then to the output channel will be directly
+	// written the accumulated slice or a part of the input slice. In this case,
+	// after the output slice is no longer used it is necessary to inform
+	// the discipline about it by calling Release() method
+	NoCopy bool
+	// Timeout for accumulation of the remainder of the input slices. If the output
+	// slice has not been filled completely in the allotted time, the data
+	// accumulated during this time is written to the output channel. A zero or
+	// negative value means that discipline will wait for the missing data until
+	// they appear or the channel is closed (in this case, the accumulated data
+	// will be written to the output channel)
+	Timeout time.Duration
+}
+
+func (opts Opts[Type]) isValid() error {
+	if opts.Input == nil {
+		return ErrInputEmpty
+	}
+
+	if opts.ChunkSize == 0 {
+		return ErrChunkSizeZero
+	}
+
+	return nil
+}
+
+func (opts Opts[Type]) normalize() Opts[Type] {
+	if opts.Clock == nil {
+		opts.Clock = clock.Real{}
+	}
+
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+
+	return opts
+}
+
+// Rechunk discipline.
+type Discipline[Type any] struct {
+	opts Opts[Type]
+
+	done    chan struct{}
+	flusher *assist.Flusher
+	join    []Type
+	output  chan []Type
+	release chan struct{}
+	timeout *assist.Timeout
+}
+
+// Creates and runs discipline.
+func New[Type any](opts Opts[Type]) (*Discipline[Type], error) {
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	opts = opts.normalize()
+
+	dsc := &Discipline[Type]{
+		opts: opts,
+
+		done:    make(chan struct{}),
+		flusher: assist.NewFlusher(),
+		join:    make([]Type, 0, opts.ChunkSize),
+		// Value returned by the cap() function is always positive and, in the case of
+		// integer overflow due to adding one, the resulting value can only become
+		// negative, which will cause a panic when executing make() as same as when
+		// specifying a large positive value
+		output:  make(chan []Type, 1+cap(opts.Input)),
+		release: make(chan struct{}),
+		timeout: assist.NewTimeout(opts.Clock, opts.Timeout, false),
+	}
+
+	go dsc.main()
+
+	return dsc, nil
+}
+
+// Returns output channel.
+//
+// If this channel is closed, it means that the discipline is terminated.
+func (dsc *Discipline[Type]) Output() <-chan []Type {
+	return dsc.output
+}
+
+// Marks output slice as no longer used.
+//
+// Must be used only if NoCopy option is set to true.
+func (dsc *Discipline[Type]) Release() {
+	select {
+	case dsc.release <- struct{}{}:
+	case <-dsc.done:
+	}
+}
+
+// Writes the accumulated remainder to the output channel without waiting for
+// the ChunkSize to be reached or the timeout to expire and without closing
+// the input channel.
+//
+// Returns after the accumulated slice has been written to the output channel,
+// immediately if nothing has been accumulated, and also if the discipline is
+// terminated. Does not wait for the Release() method call.
+func (dsc *Discipline[Type]) Flush() {
+	dsc.flusher.Flush(dsc.done)
+}
+
+func (dsc *Discipline[Type]) main() {
+	defer close(dsc.done)
+	defer close(dsc.output)
+
+	if dsc.opts.Timeout <= 0 {
+		dsc.loopUntimeouted()
+		return
+	}
+
+	dsc.loop()
+}
+
+func (dsc *Discipline[Type]) loop() {
+	defer dsc.timeout.Stop()
+
+	for {
+		select {
+		case <-dsc.opts.Context.Done():
+			dsc.pass()
+			return
+		case flushed := <-dsc.flusher.Requests():
+			dsc.flusher.Accept(flushed)
+			dsc.pass()
+		case <-dsc.timeout.C():
+			dsc.timeout.Expired()
+			dsc.pass()
+		case item, opened := <-dsc.opts.Input:
+			if !opened {
+				dsc.pass()
+				return
+			}
+
+			dsc.process(item)
+
+			if len(dsc.join) != 0 {
+				dsc.timeout.Start()
+			}
+		}
+	}
+}
+
+func (dsc *Discipline[Type]) loopUntimeouted() {
+	for {
+		select {
+		case <-dsc.opts.Context.Done():
+			dsc.pass()
+			return
+		case flushed := <-dsc.flusher.Requests():
+			dsc.flusher.Accept(flushed)
+			dsc.pass()
+		case item, opened := <-dsc.opts.Input:
+			if !opened {
+				dsc.pass()
+				return
+			}
+
+			dsc.process(item)
+		}
+	}
+}
+
+func (dsc *Discipline[Type]) process(item []Type) {
+	for len(item) != 0 {
+		// Integer overflow is impossible because len() function returns only positive
+		// values ​​for type int and the maximum value for type int is less than the
+		// maximum value for type uint
+		if len(dsc.join) == 0 && uint(len(item)) >= dsc.opts.ChunkSize {
+			// Capacity is limited so that appending to the output slice cannot
+			// overwrite the following elements of the input slice
+			dsc.forward(item[:dsc.opts.ChunkSize:dsc.opts.ChunkSize])
+
+			item = item[dsc.opts.ChunkSize:]
+
+			continue
+		}
+
+		// Conversion is safe because the accumulated slice is always smaller than
+		// the chunk size
+		missing := min(int(dsc.opts.ChunkSize)-len(dsc.join), len(item))
+
+		dsc.join = append(dsc.join, item[:missing]...)
+		item = item[missing:]
+
+		// Integer overflow is impossible because len() function returns only positive
+		// values ​​for type int and the maximum value for type int is less than the
+		// maximum value for type uint
+		if uint(len(dsc.join)) < dsc.opts.ChunkSize {
+			return
+		}
+
+		dsc.pass()
+	}
+}
+
+func (dsc *Discipline[Type]) pass() {
+	if len(dsc.join) == 0 {
+		// defer statement is not used to allow inlining of the current function
+		dsc.timeout.Pass()
+		dsc.flusher.Notify()
+
+		return
+	}
+
+	dsc.send(dsc.join)
+	dsc.resetJoin()
+	dsc.timeout.Pass()
+}
+
+// Writes a part of the input slice of the chunk size to the output channel
+// without accumulation.
+func (dsc *Discipline[Type]) forward(item []Type) {
+	dsc.send(item)
+	dsc.timeout.Pass()
+}
+
+func (dsc *Discipline[Type]) send(item []Type) {
+	item = dsc.prepareItem(item)
+
+	assist.Deliver(dsc.output, item)
+
+	dsc.flusher.Notify()
+
+	if !dsc.opts.NoCopy {
+		return
+	}
+
+	if !assist.WaitRelease(dsc.opts.Context, dsc.release) {
+		dsc.join = nil
+	}
+}
+
+func (dsc *Discipline[Type]) prepareItem(item []Type) []Type {
+	if dsc.opts.NoCopy {
+		return item
+	}
+
+	return slices.Clone(item)
+}
+
+func (dsc *Discipline[Type]) resetJoin() {
+	dsc.join = dsc.join[:0]
+}
@@ -0,0 +1,50 @@
+package rechunk_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/join/rechunk"
+)
+
+func ExampleDiscipline() {
+	data := [][]int{
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+		{13, 14},
+		{15, 16, 17, 18, 19, 20, 21, 22, 23, 24},
+		{25, 26, 27},
+	}
+
+	input := make(chan []int, 1)
+
+	opts := rechunk.Opts[int]{
+		ChunkSize: 5,
+		Input:     input,
+		Timeout:   time.Second,
+	}
+
+	discipline, err := rechunk.New(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		defer close(input)
+
+		for _, item := range data {
+			input <- item
+		}
+	}()
+
+	for chunk := range discipline.Output() {
+		fmt.Println(chunk)
+	}
+
+	// Output:
+	// [1 2 3 4 5]
+	// [6 7 8 9 10]
+	// [11 12 13 14 15]
+	// [16 17 18 19 20]
+	// [21 22 23 24 25]
+	// [26 27]
+}
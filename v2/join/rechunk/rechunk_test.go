@@ -0,0 +1,320 @@
+package rechunk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptsValidation(t *testing.T) {
+	opts := Opts[int]{
+		ChunkSize: 10,
+	}
+
+	_, err := New(opts)
+	require.ErrorIs(t, err, ErrInputEmpty)
+
+	opts = Opts[int]{
+		Input: make(chan []int),
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrChunkSizeZero)
+
+	opts = Opts[int]{
+		ChunkSize: 10,
+		Input:     make(chan []int),
+	}
+
+	_, err = New(opts)
+	require.NoError(t, err)
+}
+
+func TestDiscipline(t *testing.T) {
+	for quantity := 100; quantity <= 120; quantity++ {
+		for blockSize := 1; blockSize <= 25; blockSize++ {
+			for chunkSize := uint(1); chunkSize <= 20; chunkSize++ {
+				testDiscipline(t, quantity, blockSize, chunkSize, false, 0)
+				testDiscipline(t, quantity, blockSize, chunkSize, true, 0)
+				testDiscipline(t, quantity, blockSize, chunkSize, false, time.Minute)
+				testDiscipline(t, quantity, blockSize, chunkSize, true, time.Minute)
+			}
+		}
+	}
+}
+
+func testDiscipline(
+	t *testing.T,
+	quantity int,
+	blockSize int,
+	chunkSize uint,
+	noCopy bool,
+	timeout time.Duration,
+) {
+	input := make(chan []int, 1)
+
+	opts := Opts[int]{
+		ChunkSize: chunkSize,
+		Input:     input,
+		NoCopy:    noCopy,
+		Timeout:   timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		block := make([]int, 0, blockSize)
+
+		for item := range quantity {
+			block = append(block, item)
+
+			if len(block) == blockSize {
+				input <- block
+
+				block = make([]int, 0, blockSize)
+			}
+		}
+
+		if len(block) != 0 {
+			input <- block
+		}
+	}()
+
+	received := make([]int, 0, quantity)
+	partial := 0
+
+	for chunk := range discipline.Output() {
+		require.NotEmpty(t, chunk)
+		require.LessOrEqual(t, len(chunk), int(chunkSize))
+
+		if len(chunk) != int(chunkSize) {
+			partial++
+		}
+
+		received = append(received, chunk...)
+
+		if noCopy {
+			discipline.Release()
+		}
+	}
+
+	expected := make([]int, 0, quantity)
+
+	for item := range quantity {
+		expected = append(expected, item)
+	}
+
+	require.Equal(
+		t,
+		expected,
+		received,
+		"quantity: %v, block size: %v, chunk size: %v, no copy: %v, timeout: %v",
+		quantity,
+		blockSize,
+		chunkSize,
+		noCopy,
+		timeout,
+	)
+
+	if quantity%int(chunkSize) == 0 {
+		require.Zero(t, partial)
+	} else {
+		require.Equal(t, 1, partial)
+	}
+}
+
+func TestDisciplineNoCopy(t *testing.T) {
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		ChunkSize: 3,
+		Input:     input,
+		NoCopy:    true,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	block := []int{1, 2, 3, 4, 5, 6, 7}
+
+	input <- block
+
+	// Parts of the input slice are written without copying, but with limited
+	// capacity
+	chunk := <-discipline.Output()
+	require.Equal(t, []int{1, 2, 3}, chunk)
+	require.Equal(t, 3, cap(chunk))
+	require.Same(t, &block[0], &chunk[0])
+
+	chunk = append(chunk, 0)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, block)
+	require.Equal(t, []int{1, 2, 3, 0}, chunk)
+
+	discipline.Release()
+
+	chunk = <-discipline.Output()
+	require.Equal(t, []int{4, 5, 6}, chunk)
+	require.Same(t, &block[3], &chunk[0])
+
+	discipline.Release()
+
+	input <- []int{8, 9}
+
+	require.Equal(t, []int{7, 8, 9}, <-discipline.Output())
+
+	discipline.Release()
+
+	close(input)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineClock(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		ChunkSize: 3,
+		Clock:     manual,
+		Input:     input,
+		Timeout:   time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- []int{1, 2, 3, 4}
+
+	require.Equal(t, []int{1, 2, 3}, <-discipline.Output())
+
+	// Waiting for the timer to be started by the accumulated remainder
+	manual.BlockUntil(1)
+
+	manual.Advance(time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, []int{4}, <-discipline.Output())
+
+	input <- []int{5, 6}
+	input <- []int{7}
+
+	require.Equal(t, []int{5, 6, 7}, <-discipline.Output())
+
+	input <- []int{8}
+
+	discipline.Flush()
+	require.Equal(t, []int{8}, <-discipline.Output())
+
+	// Nothing is accumulated, so nothing is written
+	discipline.Flush()
+	require.Empty(t, discipline.Output())
+
+	input <- []int{9, 10, 11, 12, 13, 14, 15}
+
+	close(input)
+
+	require.Equal(t, []int{9, 10, 11}, <-discipline.Output())
+	require.Equal(t, []int{12, 13, 14}, <-discipline.Output())
+	require.Equal(t, []int{15}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		ChunkSize: 2,
+		Context:   ctx,
+		Input:     input,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	// Output channel has a capacity of one, so the discipline is blocked on
+	// writing of the second chunk
+	input <- []int{1, 2, 3, 4, 5, 6}
+
+	cancel()
+
+	// Writing to the output channel is not interrupted, so the input slice is
+	// delivered completely
+	require.Equal(t, []int{1, 2}, <-discipline.Output())
+	require.Equal(t, []int{3, 4}, <-discipline.Output())
+	require.Equal(t, []int{5, 6}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func BenchmarkDiscipline(b *testing.B) {
+	benchmarkDiscipline(b, false, 0)
+}
+
+func BenchmarkDisciplineNoCopy(b *testing.B) {
+	benchmarkDiscipline(b, true, 0)
+}
+
+func BenchmarkDisciplineTimeouted(b *testing.B) {
+	benchmarkDiscipline(b, false, time.Minute)
+}
+
+func BenchmarkDisciplineNoCopyTimeouted(b *testing.B) {
+	benchmarkDiscipline(b, true, time.Minute)
+}
+
+func benchmarkDiscipline(b *testing.B, noCopy bool, timeout time.Duration) {
+	const (
+		blockSize = 25
+		chunkSize = 10
+	)
+
+	input := make(chan []int, 1)
+
+	opts := Opts[int]{
+		ChunkSize: chunkSize,
+		Input:     input,
+		NoCopy:    noCopy,
+		Timeout:   timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(b, err)
+
+	block := make([]int, blockSize)
+
+	b.ResetTimer()
+
+	go func() {
+		defer close(input)
+
+		for range b.N {
+			input <- block
+		}
+	}()
+
+	for chunk := range discipline.Output() {
+		require.NotEmpty(b, chunk)
+
+		if noCopy {
+			discipline.Release()
+		}
+	}
+}
@@ -0,0 +1,295 @@
+// Discipline used to accumulate elements from an input channel into an arbitrary
+// accumulator, for example, a counter, a sum or a map, and write that
+// accumulator to an output channel when its maximum size or timeout for its
+// accumulation is reached. It generalizes the join discipline to any type of
+// accumulator.
+package reduce
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+	"github.com/akramarenkov/cqos/v2/join/defaults"
+	"github.com/akramarenkov/cqos/v2/join/internal/assist"
+)
+
+var (
+	ErrAddEmpty     = errors.New("add function was not specified")
+	ErrInitEmpty    = errors.New("init function was not specified")
+	ErrInputEmpty   = errors.New("input channel was not specified")
+	ErrJoinSizeZero = errors.New("join size is zero")
+	ErrSizeEmpty    = errors.New("size function was not specified")
+)
+
+// Options of the created discipline.
+type Opts[Acc any, Type any] struct {
+	// Adds an element to the accumulator and returns the resulting accumulator
+	Add func(Acc, Type) Acc
+	// Source of the current time and timers. By default, the wall clock is used.
+	// Can be replaced with the clock.Manual for deterministic testing
+	Clock clock.Clock
+	// Context whose completion terminates the discipline without waiting for
+	// the input channel to be closed. At termination, the accumulator is written
+	// to the output channel, so no data is lost, and therefore the output channel
+	// must be read until it is closed. Waiting for the Release() method call is
+	// interrupted. By default, the discipline is terminated only by closing
+	// the input channel
+	Context context.Context
+	// Returns a new empty accumulator
+	Init func() Acc
+	// Input data channel. For terminate discipline it is necessary and sufficient to
+	// close the input channel
+	Input <-chan Type
+	// Maximum size of the accumulator. Actual size of the output accumulator may be
+	// smaller due to the timeout or closure of the input channel
+	JoinSize uint
+	// By default, to the output channel is written the accumulator and a new one is
+	// immediately created by the Init function to continue accumulation. If
+	// the NoCopy is set to true, then after the accumulator is written to
+	// the output channel, the discipline waits for a call to the Release() method,
+	// after which the released accumulator is cleared by the Reset function and
+	// accumulation continues into it
+	NoCopy bool
+	// Clears the released accumulator for reuse and returns the resulting
+	// accumulator, for example, truncates a slice or clears a map. Used only in
+	// the NoCopy mode. If it is not specified or waiting for the Release() method
+	// call is interrupted by the context completion, a new accumulator is created
+	// by the Init function
+	Reset func(Acc) Acc
+	// Returns the size of the accumulator, for example, the quantity of elements
+	// added to it or the quantity of keys in a map
+	Size func(Acc) uint
+	// Timeout for accumulation. If the accumulator has not been filled completely
+	// in the allotted time, the accumulator is written to the output channel.
+	// A zero or negative value means that discipline will wait for the missing
+	// data until they appear or the channel is closed (in this case, the accumulator
+	// will be written to the output channel)
+	Timeout time.Duration
+	// Previously, the timeout expiration was checked by a ticker with a period
+	// several times shorter than the timeout and this parameter set the inaccuracy
+	// of the check in percents. It is validated for compatibility with the join
+	// and unite disciplines but does not affect the discipline
+	//
+	// Deprecated: the timeout is measured exactly regardless of this value
+	TimeoutInaccuracy uint
+}
+
+func (opts Opts[Acc, Type]) isValid() error {
+	if opts.Input == nil {
+		return ErrInputEmpty
+	}
+
+	if opts.JoinSize == 0 {
+		return ErrJoinSizeZero
+	}
+
+	if opts.Init == nil {
+		return ErrInitEmpty
+	}
+
+	if opts.Add == nil {
+		return ErrAddEmpty
+	}
+
+	if opts.Size == nil {
+		return ErrSizeEmpty
+	}
+
+	return nil
+}
+
+func (opts Opts[Acc, Type]) normalize() Opts[Acc, Type] {
+	if opts.Clock == nil {
+		opts.Clock = clock.Real{}
+	}
+
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+
+	if opts.TimeoutInaccuracy == 0 {
+		opts.TimeoutInaccuracy = defaults.TimeoutInaccuracy
+	}
+
+	return opts
+}
+
+// Reduce discipline.
+type Discipline[Acc any, Type any] struct {
+	opts Opts[Acc, Type]
+
+	acc     Acc
+	added   bool
+	done    chan struct{}
+	flusher *assist.Flusher
+	output  chan Acc
+	release chan struct{}
+	timeout *assist.Timeout
+}
+
+// Creates and runs discipline.
+func New[Acc any, Type any](opts Opts[Acc, Type]) (*Discipline[Acc, Type], error) {
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	opts = opts.normalize()
+
+	// Inaccuracy is no longer used, but is validated for compatibility
+	if _, err := calcInterruptInterval(opts.Timeout, opts.TimeoutInaccuracy); err != nil {
+		return nil, err
+	}
+
+	dsc := &Discipline[Acc, Type]{
+		opts: opts,
+
+		acc:     opts.Init(),
+		done:    make(chan struct{}),
+		flusher: assist.NewFlusher(),
+		// Value returned by the cap() function is always positive and, in the case of
+		// integer overflow due to adding one, the resulting value can only become
+		// negative, which will cause a panic when executing make() as same as when
+		// specifying a large positive value
+		output:  make(chan Acc, 1+cap(opts.Input)),
+		release: make(chan struct{}),
+		timeout: assist.NewTimeout(opts.Clock, opts.Timeout, false),
+	}
+
+	go dsc.main()
+
+	return dsc, nil
+}
+
+// Returns output channel.
+//
+// If this channel is closed, it means that the discipline is terminated.
+func (dsc *Discipline[Acc, Type]) Output() <-chan Acc {
+	return dsc.output
+}
+
+// Marks accumulator as no longer used.
+//
+// Must be used only if NoCopy option is set to true.
+func (dsc *Discipline[Acc, Type]) Release() {
+	select {
+	case dsc.release <- struct{}{}:
+	case <-dsc.done:
+	}
+}
+
+// Writes the accumulator to the output channel without waiting for the JoinSize
+// to be reached or the timeout to expire and without closing the input channel.
+//
+// Returns after the accumulator has been written to the output channel,
+// immediately if nothing has been accumulated, and also if the discipline is
+// terminated. Does not wait for the Release() method call.
+func (dsc *Discipline[Acc, Type]) Flush() {
+	dsc.flusher.Flush(dsc.done)
+}
+
+func (dsc *Discipline[Acc, Type]) main() {
+	defer close(dsc.done)
+	defer close(dsc.output)
+
+	if dsc.opts.Timeout <= 0 {
+		dsc.loopUntimeouted()
+		return
+	}
+
+	dsc.loop()
+}
+
+func (dsc *Discipline[Acc, Type]) loop() {
+	defer dsc.timeout.Stop()
+
+	for {
+		select {
+		case <-dsc.opts.Context.Done():
+			dsc.pass()
+			return
+		case flushed := <-dsc.flusher.Requests():
+			dsc.flusher.Accept(flushed)
+			dsc.pass()
+		case <-dsc.timeout.C():
+			dsc.timeout.Expired()
+			dsc.pass()
+		case item, opened := <-dsc.opts.Input:
+			if !opened {
+				dsc.pass()
+				return
+			}
+
+			dsc.process(item)
+
+			if dsc.added {
+				dsc.timeout.Start()
+			}
+		}
+	}
+}
+
+func (dsc *Discipline[Acc, Type]) loopUntimeouted() {
+	for {
+		select {
+		case <-dsc.opts.Context.Done():
+			dsc.pass()
+			return
+		case flushed := <-dsc.flusher.Requests():
+			dsc.flusher.Accept(flushed)
+			dsc.pass()
+		case item, opened := <-dsc.opts.Input:
+			if !opened {
+				dsc.pass()
+				return
+			}
+
+			dsc.process(item)
+		}
+	}
+}
+
+func (dsc *Discipline[Acc, Type]) process(item Type) {
+	dsc.acc = dsc.opts.Add(dsc.acc, item)
+	dsc.added = true
+
+	if dsc.opts.Size(dsc.acc) < dsc.opts.JoinSize {
+		return
+	}
+
+	dsc.pass()
+}
+
+func (dsc *Discipline[Acc, Type]) pass() {
+	if !dsc.added {
+		// defer statement is not used to allow inlining of the current function
+		dsc.timeout.Pass()
+		dsc.flusher.Notify()
+
+		return
+	}
+
+	dsc.send()
+	dsc.timeout.Pass()
+}
+
+func (dsc *Discipline[Acc, Type]) send() {
+	assist.Deliver(dsc.output, dsc.acc)
+
+	dsc.flusher.Notify()
+
+	dsc.added = false
+
+	if !dsc.opts.NoCopy {
+		dsc.acc = dsc.opts.Init()
+		return
+	}
+
+	if !assist.WaitRelease(dsc.opts.Context, dsc.release) || dsc.opts.Reset == nil {
+		dsc.acc = dsc.opts.Init()
+		return
+	}
+
+	dsc.acc = dsc.opts.Reset(dsc.acc)
+}
@@ -0,0 +1,60 @@
+package reduce_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/join/reduce"
+)
+
+func ExampleDiscipline() {
+	type update struct {
+		id    int
+		value string
+	}
+
+	data := []update{
+		{id: 1, value: "a"},
+		{id: 2, value: "b"},
+		{id: 1, value: "c"},
+		{id: 3, value: "d"},
+		{id: 4, value: "e"},
+		{id: 4, value: "f"},
+	}
+
+	input := make(chan update, 2)
+
+	// Last-write-wins deduplication of updates by their IDs
+	opts := reduce.Opts[map[int]string, update]{
+		Add: func(acc map[int]string, item update) map[int]string {
+			acc[item.id] = item.value
+			return acc
+		},
+		Init:     func() map[int]string { return make(map[int]string) },
+		Input:    input,
+		JoinSize: 3,
+		Size:     func(acc map[int]string) uint { return uint(len(acc)) },
+		Timeout:  time.Second,
+	}
+
+	discipline, err := reduce.New(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		defer close(input)
+
+		for _, item := range data {
+			input <- item
+		}
+	}()
+
+	for acc := range discipline.Output() {
+		fmt.Println(acc)
+	}
+
+	// Output:
+	// map[1:c 2:b 3:d]
+	// map[4:f]
+}
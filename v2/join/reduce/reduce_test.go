@@ -0,0 +1,356 @@
+package reduce
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sum struct {
+	quantity uint
+	total    int
+}
+
+func sumOpts(input chan int, joinSize uint) Opts[sum, int] {
+	opts := Opts[sum, int]{
+		Add: func(acc sum, item int) sum {
+			acc.quantity++
+			acc.total += item
+
+			return acc
+		},
+		Init:     func() sum { return sum{} },
+		Input:    input,
+		JoinSize: joinSize,
+		Size:     func(acc sum) uint { return acc.quantity },
+	}
+
+	return opts
+}
+
+// Last-write-wins deduplication of elements by their keys.
+func dedupOpts(input chan [2]int, joinSize uint) Opts[map[int]int, [2]int] {
+	opts := Opts[map[int]int, [2]int]{
+		Add: func(acc map[int]int, item [2]int) map[int]int {
+			acc[item[0]] = item[1]
+			return acc
+		},
+		Init:     func() map[int]int { return make(map[int]int) },
+		Input:    input,
+		JoinSize: joinSize,
+		NoCopy:   true,
+		Reset: func(acc map[int]int) map[int]int {
+			clear(acc)
+			return acc
+		},
+		Size: func(acc map[int]int) uint { return uint(len(acc)) },
+	}
+
+	return opts
+}
+
+func TestOptsValidation(t *testing.T) {
+	input := make(chan int)
+
+	opts := sumOpts(nil, 10)
+
+	_, err := New(opts)
+	require.ErrorIs(t, err, ErrInputEmpty)
+
+	opts = sumOpts(input, 0)
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrJoinSizeZero)
+
+	opts = sumOpts(input, 10)
+	opts.Init = nil
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrInitEmpty)
+
+	opts = sumOpts(input, 10)
+	opts.Add = nil
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrAddEmpty)
+
+	opts = sumOpts(input, 10)
+	opts.Size = nil
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrSizeEmpty)
+
+	opts = sumOpts(input, 10)
+	opts.Timeout = time.Second
+	opts.TimeoutInaccuracy = 101
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrTimeoutInaccuracyTooBig)
+
+	opts = sumOpts(input, 10)
+
+	_, err = New(opts)
+	require.NoError(t, err)
+}
+
+func TestDiscipline(t *testing.T) {
+	for joinSize := uint(1); joinSize <= 10; joinSize++ {
+		testDiscipline(t, 1000, joinSize, 0)
+		testDiscipline(t, 1000, joinSize, time.Minute)
+	}
+}
+
+func testDiscipline(t *testing.T, quantity int, joinSize uint, timeout time.Duration) {
+	input := make(chan int, joinSize)
+
+	opts := sumOpts(input, joinSize)
+	opts.Timeout = timeout
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		for item := range quantity {
+			input <- item
+		}
+	}()
+
+	received := sum{}
+
+	for acc := range discipline.Output() {
+		require.NotZero(t, acc.quantity)
+		require.LessOrEqual(t, acc.quantity, joinSize)
+
+		received.quantity += acc.quantity
+		received.total += acc.total
+	}
+
+	require.Equal(
+		t,
+		sum{quantity: uint(quantity), total: quantity * (quantity - 1) / 2},
+		received,
+		"join size: %v, timeout: %v",
+		joinSize,
+		timeout,
+	)
+}
+
+func TestDisciplineNoCopy(t *testing.T) {
+	input := make(chan [2]int)
+
+	discipline, err := New(dedupOpts(input, 2))
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		input <- [2]int{1, 1}
+		input <- [2]int{1, 2}
+		input <- [2]int{2, 1}
+		input <- [2]int{3, 1}
+		input <- [2]int{3, 2}
+		input <- [2]int{3, 3}
+	}()
+
+	expected := []map[int]int{
+		{1: 2, 2: 1},
+		{3: 3},
+	}
+
+	received := make([]map[int]int, 0, len(expected))
+	pointers := make([]uintptr, 0, len(expected))
+
+	for acc := range discipline.Output() {
+		// Accumulator is cleared for reuse after release, so it is copied
+		received = append(received, maps.Clone(acc))
+		pointers = append(pointers, reflect.ValueOf(acc).Pointer())
+
+		discipline.Release()
+	}
+
+	require.Equal(t, expected, received)
+	require.Len(t, pointers, len(expected))
+	require.Equal(t, pointers[0], pointers[1])
+}
+
+func TestDisciplineNoCopyWithoutReset(t *testing.T) {
+	input := make(chan [2]int)
+
+	opts := dedupOpts(input, 1)
+	opts.Reset = nil
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		input <- [2]int{1, 1}
+		input <- [2]int{2, 1}
+	}()
+
+	received := make([]map[int]int, 0, 2)
+
+	for acc := range discipline.Output() {
+		// Accumulator is created by the Init function after release, so it is not
+		// modified by the discipline
+		received = append(received, acc)
+
+		discipline.Release()
+	}
+
+	require.Equal(t, []map[int]int{{1: 1}, {2: 1}}, received)
+}
+
+func TestDisciplineClock(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	opts := sumOpts(input, 3)
+	opts.Clock = manual
+	opts.Timeout = time.Second
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+
+	// Waiting for the timer to be started by the first accumulated element
+	manual.BlockUntil(1)
+
+	input <- 2
+
+	manual.Advance(time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, sum{quantity: 2, total: 3}, <-discipline.Output())
+
+	input <- 3
+	input <- 4
+	input <- 5
+
+	require.Equal(t, sum{quantity: 3, total: 12}, <-discipline.Output())
+
+	input <- 6
+
+	discipline.Flush()
+	require.Equal(t, sum{quantity: 1, total: 6}, <-discipline.Output())
+
+	// Nothing is accumulated, so nothing is written
+	discipline.Flush()
+	require.Empty(t, discipline.Output())
+
+	input <- 7
+
+	close(input)
+
+	require.Equal(t, sum{quantity: 1, total: 7}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan [2]int)
+
+	opts := dedupOpts(input, 2)
+	opts.Context = ctx
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- [2]int{1, 1}
+	input <- [2]int{2, 1}
+
+	require.Equal(t, map[int]int{1: 1, 2: 1}, <-discipline.Output())
+
+	// Discipline waits for the Release() method call and does not receive
+	// elements
+	select {
+	case input <- [2]int{3, 1}:
+		require.FailNow(t, "element is received before release")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cancel()
+
+	// Waiting for the Release() method call is interrupted
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineContextAccumulated(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan int)
+
+	opts := sumOpts(input, 2)
+	opts.Context = ctx
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+	input <- 2
+	input <- 3
+
+	cancel()
+
+	// Output channel has a capacity of one, but writing to it is not interrupted,
+	// so the accumulator is delivered
+	require.Equal(t, sum{quantity: 2, total: 3}, <-discipline.Output())
+	require.Equal(t, sum{quantity: 1, total: 3}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func BenchmarkDiscipline(b *testing.B) {
+	benchmarkDiscipline(b, 0)
+}
+
+func BenchmarkDisciplineTimeouted(b *testing.B) {
+	benchmarkDiscipline(b, time.Minute)
+}
+
+func benchmarkDiscipline(b *testing.B, timeout time.Duration) {
+	const joinSize = 10
+
+	input := make(chan int, joinSize)
+
+	opts := sumOpts(input, joinSize)
+	opts.Timeout = timeout
+
+	discipline, err := New(opts)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	go func() {
+		defer close(input)
+
+		for item := range b.N * joinSize {
+			input <- item
+		}
+	}()
+
+	for acc := range discipline.Output() {
+		require.NotZero(b, acc.quantity)
+	}
+}
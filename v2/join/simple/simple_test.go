@@ -0,0 +1,261 @@
+package simple
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errHandle = errors.New("handle error")
+
+func TestOptsValidation(t *testing.T) {
+	handle := func(context.Context, []int) error { return nil }
+
+	opts := Opts[int]{
+		HandlersQuantity: 1,
+		Input:            make(chan int),
+		JoinSize:         10,
+	}
+
+	_, err := New(opts)
+	require.ErrorIs(t, err, ErrHandleEmpty)
+
+	opts = Opts[int]{
+		Handle:   handle,
+		Input:    make(chan int),
+		JoinSize: 10,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrHandlersQuantityZero)
+
+	opts = Opts[int]{
+		Handle:           handle,
+		HandlersQuantity: 1,
+		JoinSize:         10,
+	}
+
+	_, err = New(opts)
+	require.Error(t, err)
+
+	opts = Opts[int]{
+		Handle:           handle,
+		HandlersQuantity: 1,
+		Input:            make(chan int),
+		JoinSize:         10,
+	}
+
+	_, err = New(opts)
+	require.NoError(t, err)
+}
+
+func TestDiscipline(t *testing.T) {
+	for handlersQuantity := uint(1); handlersQuantity <= 5; handlersQuantity++ {
+		testDiscipline(t, 1000, handlersQuantity, 10, 0)
+		testDiscipline(t, 1000, handlersQuantity, 10, time.Millisecond)
+	}
+}
+
+func testDiscipline(
+	t *testing.T,
+	quantity int,
+	handlersQuantity uint,
+	joinSize uint,
+	timeout time.Duration,
+) {
+	input := make(chan int, joinSize)
+
+	active := &atomic.Int64{}
+	maxActive := &atomic.Int64{}
+
+	mutex := &sync.Mutex{}
+	received := make([]bool, quantity)
+
+	handle := func(_ context.Context, join []int) error {
+		current := active.Add(1)
+		defer active.Add(-1)
+
+		for {
+			previous := maxActive.Load()
+
+			if current <= previous || maxActive.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		require.NotEmpty(t, join)
+		require.LessOrEqual(t, len(join), int(joinSize))
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		for _, item := range join {
+			require.False(t, received[item])
+
+			received[item] = true
+		}
+
+		return nil
+	}
+
+	opts := Opts[int]{
+		Handle:           handle,
+		HandlersQuantity: handlersQuantity,
+		Input:            input,
+		JoinSize:         joinSize,
+		Timeout:          timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		for item := range quantity {
+			input <- item
+		}
+	}()
+
+	require.NoError(t, <-discipline.Err())
+
+	_, opened := <-discipline.Err()
+	require.False(t, opened)
+
+	require.NotContains(t, received, false)
+	require.LessOrEqual(t, maxActive.Load(), int64(handlersQuantity))
+}
+
+func TestDisciplineError(t *testing.T) {
+	input := make(chan int)
+
+	handle := func(_ context.Context, join []int) error {
+		if join[0] >= 50 {
+			return errHandle
+		}
+
+		return nil
+	}
+
+	opts := Opts[int]{
+		Handle:           handle,
+		HandlersQuantity: 2,
+		Input:            input,
+		JoinSize:         10,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	stop := make(chan struct{})
+
+	// Input channel is not closed, the discipline is terminated by the error
+	go func() {
+		for item := 0; ; item++ {
+			select {
+			case <-stop:
+				return
+			case input <- item:
+			}
+		}
+	}()
+
+	require.ErrorIs(t, <-discipline.Err(), errHandle)
+
+	close(stop)
+}
+
+func TestDisciplineErrorWriting(t *testing.T) {
+	input := make(chan int)
+
+	handle := func(context.Context, []int) error {
+		return errHandle
+	}
+
+	opts := Opts[int]{
+		Handle:           handle,
+		HandlersQuantity: 1,
+		Input:            input,
+		JoinSize:         10,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	// Writer does not know about the error and writes all the data
+	for item := range 1000 {
+		input <- item
+	}
+
+	close(input)
+
+	require.ErrorIs(t, <-discipline.Err(), errHandle)
+}
+
+func TestDisciplineContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan int)
+
+	handled := make(chan []int)
+
+	handle := func(_ context.Context, join []int) error {
+		handled <- append([]int(nil), join...)
+		return nil
+	}
+
+	opts := Opts[int]{
+		Context:          ctx,
+		Handle:           handle,
+		HandlersQuantity: 1,
+		Input:            input,
+		JoinSize:         2,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 1
+	input <- 2
+
+	require.Equal(t, []int{1, 2}, <-handled)
+
+	cancel()
+
+	// Input channel is not closed, the discipline is terminated by the context
+	require.NoError(t, <-discipline.Err())
+}
+
+func BenchmarkDiscipline(b *testing.B) {
+	const joinSize = 10
+
+	input := make(chan int, joinSize)
+
+	opts := Opts[int]{
+		Handle:           func(context.Context, []int) error { return nil },
+		HandlersQuantity: 4,
+		Input:            input,
+		JoinSize:         joinSize,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	go func() {
+		defer close(input)
+
+		for item := range b.N * joinSize {
+			input <- item
+		}
+	}()
+
+	require.NoError(b, <-discipline.Err())
+}
@@ -0,0 +1,114 @@
+//go:build unix
+
+package unite
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/internal/consts"
+	"github.com/akramarenkov/cqos/v2/join/defaults"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	idlePeriod  = 10 * time.Millisecond
+	idleTimeout = time.Millisecond
+)
+
+// Discipline does not receive slices, so it should not consume CPU time.
+func BenchmarkDisciplineIdle(b *testing.B) {
+	benchmarkDisciplineIdle(b, idleTimeout, false)
+}
+
+// Discipline waits for the timeout to expire, so it should not consume CPU time
+// until then.
+func BenchmarkDisciplineIdleAccumulated(b *testing.B) {
+	benchmarkDisciplineIdle(b, time.Hour, true)
+}
+
+// Polling of the timeout expiration by a ticker, as was done before the timer
+// was used, for comparison.
+func BenchmarkDisciplineIdlePolling(b *testing.B) {
+	interval := idleTimeout / time.Duration(consts.HundredPercent/defaults.TimeoutInaccuracy)
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		passAt := time.Now()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if time.Since(passAt) >= idleTimeout {
+					passAt = time.Now()
+				}
+			}
+		}
+	}()
+
+	measureIdle(b)
+
+	close(done)
+	<-stopped
+}
+
+func benchmarkDisciplineIdle(b *testing.B, timeout time.Duration, accumulated bool) {
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Input:    input,
+		JoinSize: 10,
+		Timeout:  timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(b, err)
+
+	if accumulated {
+		input <- []int{1}
+	}
+
+	measureIdle(b)
+
+	close(input)
+
+	for join := range discipline.Output() {
+		require.NotEmpty(b, join)
+	}
+}
+
+// Sleeps for the idle period in each iteration and reports the CPU time consumed
+// by the process per iteration.
+func measureIdle(b *testing.B) {
+	startedAt := cpuTime(b)
+
+	b.ResetTimer()
+
+	for range b.N {
+		time.Sleep(idlePeriod)
+	}
+
+	b.StopTimer()
+
+	b.ReportMetric(float64(cpuTime(b)-startedAt)/float64(b.N), "cpu-ns/op")
+}
+
+func cpuTime(b *testing.B) time.Duration {
+	usage := syscall.Rusage{}
+
+	err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage)
+	require.NoError(b, err)
+
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano())
+}
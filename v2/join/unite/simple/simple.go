@@ -0,0 +1,206 @@
+// Simplified version of the unite discipline that runs handlers of the accumulated
+// slices on its own.
+package simple
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/join/unite"
+)
+
+var (
+	ErrHandleEmpty          = errors.New("handle function was not specified")
+	ErrHandlersQuantityZero = errors.New("handlers quantity is zero")
+)
+
+// Callback function called in handlers when an accumulated slice is received.
+//
+// The slice is reused by the discipline after the function returns, so it must
+// not be used after that.
+type Handle[Type any] func(ctx context.Context, join []Type) error
+
+// Options of the created discipline.
+type Opts[Type any] struct {
+	// Context whose completion terminates the discipline without waiting for
+	// the input channel to be closed. It is also passed to the callback function.
+	// By default, the discipline is terminated only by closing the input channel
+	Context context.Context
+	// Callback function called in handlers when an accumulated slice is received
+	Handle Handle[Type]
+	// Quantity of handlers that process the accumulated slices concurrently
+	HandlersQuantity uint
+	// Input data channel. For terminate discipline it is necessary and sufficient to
+	// close the input channel
+	Input <-chan []Type
+	// Maximum size of the accumulated slice. Actual size of the accumulated slice
+	// may be smaller due to the timeout or closure of the input channel and the fact
+	// that the input slices accumulate entirely. Also, the actual size of
+	// the accumulated slice may be larger if an slice larger than the maximum size
+	// is received at the input
+	JoinSize uint
+	// Timeout for slice accumulation. A zero or negative value means that
+	// discipline will wait for the missing data until they appear or the channel is
+	// closed
+	Timeout time.Duration
+}
+
+func (opts Opts[Type]) isValid() error {
+	if opts.Handle == nil {
+		return ErrHandleEmpty
+	}
+
+	if opts.HandlersQuantity == 0 {
+		return ErrHandlersQuantityZero
+	}
+
+	return nil
+}
+
+func (opts Opts[Type]) normalize() Opts[Type] {
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+
+	return opts
+}
+
+// Simplified unite discipline.
+type Discipline[Type any] struct {
+	opts Opts[Type]
+
+	cancel context.CancelFunc
+	ctx    context.Context
+	err    chan error
+	errs   []error
+	unite  *unite.Discipline[Type]
+	mutex  *sync.Mutex
+	wg     *sync.WaitGroup
+}
+
+// Creates and runs discipline.
+func New[Type any](opts Opts[Type]) (*Discipline[Type], error) {
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	opts = opts.normalize()
+
+	ctx, cancel := context.WithCancel(opts.Context)
+
+	// Each handler and the accumulation use their own buffer, so the buffers are
+	// reused and the next slice is accumulated while all handlers are busy
+	uniteOpts := unite.Opts[Type]{
+		Context:  ctx,
+		Input:    opts.Input,
+		JoinSize: opts.JoinSize,
+		NoCopy:   true,
+		PoolSize: opts.HandlersQuantity + 1,
+		Timeout:  opts.Timeout,
+	}
+
+	unite, err := unite.New(uniteOpts)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	dsc := &Discipline[Type]{
+		opts: opts,
+
+		cancel: cancel,
+		ctx:    ctx,
+		err:    make(chan error, 1),
+		unite:  unite,
+		mutex:  &sync.Mutex{},
+		wg:     &sync.WaitGroup{},
+	}
+
+	dsc.main()
+
+	return dsc, nil
+}
+
+// Returns a channel with errors. If a handler returns an error, the discipline
+// terminates its work. The accumulated slices that have not yet been passed to
+// handlers at that moment, as well as at the completion of the context, are
+// discarded.
+//
+// After a handler error, the data received from the input channel are also
+// discarded until it is closed or the context is completed, so the writers to
+// the input channel are not blocked, but the input channel still must be closed.
+//
+// A single value is written to the channel after all the handlers have returned:
+// the errors returned by the handlers joined together or nil if the discipline
+// has terminated in normal mode. After that the channel is closed.
+func (dsc *Discipline[Type]) Err() <-chan error {
+	return dsc.err
+}
+
+func (dsc *Discipline[Type]) main() {
+	for range dsc.opts.HandlersQuantity {
+		dsc.wg.Add(1)
+
+		go dsc.handler()
+	}
+
+	go dsc.wait()
+}
+
+func (dsc *Discipline[Type]) wait() {
+	defer close(dsc.err)
+	defer dsc.cancel()
+
+	dsc.wg.Wait()
+
+	if len(dsc.errs) != 0 {
+		go dsc.drain()
+	}
+
+	dsc.err <- errors.Join(dsc.errs...)
+}
+
+// Discards the data received from the input channel after the discipline is
+// terminated by a handler error.
+func (dsc *Discipline[Type]) drain() {
+	for {
+		select {
+		case <-dsc.opts.Context.Done():
+			return
+		case _, opened := <-dsc.opts.Input:
+			if !opened {
+				return
+			}
+		}
+	}
+}
+
+func (dsc *Discipline[Type]) handler() {
+	defer dsc.wg.Done()
+
+	for join := range dsc.unite.Output() {
+		dsc.handle(join)
+		dsc.unite.Recycle(join)
+	}
+}
+
+func (dsc *Discipline[Type]) handle(join []Type) {
+	if dsc.ctx.Err() != nil {
+		return
+	}
+
+	if err := dsc.opts.Handle(dsc.ctx, join); err != nil {
+		dsc.fail(err)
+	}
+}
+
+func (dsc *Discipline[Type]) fail(err error) {
+	dsc.mutex.Lock()
+	defer dsc.mutex.Unlock()
+
+	dsc.errs = append(dsc.errs, err)
+
+	dsc.cancel()
+}
@@ -0,0 +1,70 @@
+package simple_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/join/unite/simple"
+)
+
+func ExampleDiscipline() {
+	input := make(chan []int, 10)
+
+	// Slice is reused after the handle function returns, so only the total is
+	// sent from it
+	totals := make(chan int)
+
+	handle := func(_ context.Context, join []int) error {
+		total := 0
+
+		for _, item := range join {
+			total += item
+		}
+
+		totals <- total
+
+		return nil
+	}
+
+	opts := simple.Opts[int]{
+		Handle:           handle,
+		HandlersQuantity: 2,
+		Input:            input,
+		JoinSize:         10,
+		Timeout:          time.Second,
+	}
+
+	discipline, err := simple.New(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		defer close(input)
+
+		for item := 0; item < 100; item += 5 {
+			input <- []int{item, item + 1, item + 2, item + 3, item + 4}
+		}
+	}()
+
+	sum := 0
+
+	for {
+		select {
+		case total := <-totals:
+			sum += total
+		case err := <-discipline.Err():
+			if err != nil {
+				panic(err)
+			}
+
+			fmt.Println(sum)
+
+			return
+		}
+	}
+
+	// Output:
+	// 4950
+}
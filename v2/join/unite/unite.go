@@ -6,20 +6,48 @@
 package unite
 
 import (
+	"context"
 	"errors"
 	"slices"
 	"time"
 
+	"github.com/akramarenkov/cqos/v2/clock"
+	"github.com/akramarenkov/cqos/v2/join/batch"
 	"github.com/akramarenkov/cqos/v2/join/defaults"
+	"github.com/akramarenkov/cqos/v2/join/internal/assist"
 )
 
 var (
-	ErrInputEmpty   = errors.New("input channel was not specified")
-	ErrJoinSizeZero = errors.New("join size is zero")
+	ErrInputEmpty            = errors.New("input channel was not specified")
+	ErrJoinSizeZero          = errors.New("join size is zero")
+	ErrPoolSizeWithoutNoCopy = errors.New("pool size is specified without no copy mode")
 )
 
 // Options of the created discipline.
 type Opts[Type any] struct {
+	// Interval of the alignment windows. If it is specified, then, in addition to
+	// the other conditions, the accumulated slice is written to the output channel
+	// when the end of the window in which its elements were received is reached.
+	// The window boundaries are multiples of the interval since the zero time
+	// (in UTC) shifted by the AlignOffset, so, for example, an interval of one
+	// minute closes the windows on every full minute of the wall clock regardless
+	// of the last writing to the output channel. The start time of the window is
+	// specified in the metadata of the slice. A zero or negative value means that
+	// the alignment is not used
+	AlignInterval time.Duration
+	// Shift of the alignment window boundaries relative to the multiples of
+	// the AlignInterval
+	AlignOffset time.Duration
+	// Source of the current time and timers. By default, the wall clock is used.
+	// Can be replaced with the clock.Manual for deterministic testing
+	Clock clock.Clock
+	// Context whose completion terminates the discipline without waiting for
+	// the input channel to be closed. At termination, the accumulated slice is
+	// written to the output channel, so no data is lost, and therefore the output
+	// channel must be read until it is closed. Waiting for the Release() method
+	// call is interrupted. By default, the discipline is terminated only by closing
+	// the input channel
+	Context context.Context
 	// Input data channel. For terminate discipline it is necessary and sufficient to
 	// close the input channel. Preferably input channel should be buffered for
 	// performance reasons. Optimal capacity is in the range of one to three JoinSize
@@ -30,25 +58,44 @@ type Opts[Type any] struct {
 	// slice may be larger if an slice larger than the maximum size is received at
 	// the input
 	JoinSize uint
+	// If the Metadata is set to true, then the accumulated slices are written
+	// together with the information about their accumulation (reason for writing,
+	// time of receiving the first and last data elements and sequence number) to
+	// the channel returned by the Batches() method instead of the output channel
+	Metadata bool
 	// By default, to the output channel is written a copy of the accumulated slice
 	// If the NoCopy is set to true, then to the output channel will be directly
 	// written the accumulated slice. In this case, after the accumulated slice is
 	// no longer used it is necessary to inform the discipline about it by calling
 	// Release() method
 	NoCopy bool
+	// Quantity of buffers used to accumulate slices in the NoCopy mode. If it is
+	// specified, then the discipline does not wait for the Release() method call
+	// after writing the accumulated slice to the output channel, but continues
+	// accumulation into another buffer from the pool. In this case, each slice
+	// received from the output channel must be returned to the pool by calling
+	// the Recycle() method with this slice as an argument. The discipline waits
+	// for a buffer to be returned only if all the buffers are in use
+	PoolSize uint
 	// Timeout for slice accumulation. If the slice has not been filled completely
 	// in the allotted time, the data accumulated during this time is written to
 	// the output channel. A zero or negative value means that discipline will wait
 	// for the missing data until they appear or the channel is closed (in this case,
 	// the accumulated data will be written to the output channel)
 	Timeout time.Duration
-	// Due to the fact that it is not possible to reliably reset the timer/ticker
-	// (without false ticks), a ticker with a duration several times shorter than
-	// the timeout is used and to determine the expiration of the timeout,
-	// the current time is compared with the time of the last writing to
-	// the output channel. This method has an inaccuracy that can be set by
-	// this parameter in percents. The lower this value, the lower the performance of
-	// the discipline (due to frequent interruptions to check for timeout expiration)
+	// By default, the timeout is measured from the last writing to the output
+	// channel. If the TimeoutFromFirst is set to true, then the timeout is measured
+	// from the receiving of the first element of the accumulated slice, which
+	// strictly bounds the time that any element waits in the accumulated slice
+	TimeoutFromFirst bool
+	// Previously, the timeout expiration was checked by a ticker with a period
+	// several times shorter than the timeout and this parameter set the inaccuracy
+	// of the check in percents. Now a resettable timer is used, so the timeout is
+	// exact and there are no periodic interruptions while nothing is accumulated.
+	// The parameter is still validated for compatibility but no longer affects
+	// the discipline
+	//
+	// Deprecated: the timeout is measured exactly regardless of this value
 	TimeoutInaccuracy uint
 }
 
@@ -61,10 +108,22 @@ func (opts Opts[Type]) isValid() error {
 		return ErrJoinSizeZero
 	}
 
+	if opts.PoolSize != 0 && !opts.NoCopy {
+		return ErrPoolSizeWithoutNoCopy
+	}
+
 	return nil
 }
 
 func (opts Opts[Type]) normalize() Opts[Type] {
+	if opts.Clock == nil {
+		opts.Clock = clock.Real{}
+	}
+
+	if opts.Context == nil {
+		opts.Context = context.Background()
+	}
+
 	if opts.TimeoutInaccuracy == 0 {
 		opts.TimeoutInaccuracy = defaults.TimeoutInaccuracy
 	}
@@ -76,11 +135,21 @@ func (opts Opts[Type]) normalize() Opts[Type] {
 type Discipline[Type any] struct {
 	opts Opts[Type]
 
-	interruptInterval time.Duration
-	join              []Type
-	output            chan []Type
-	passAt            time.Time
-	release           chan struct{}
+	alignActive bool
+	alignTimer  clock.Timer
+	batches     chan batch.Batch[Type]
+	allocated   uint
+	done        chan struct{}
+	firstAt     time.Time
+	flusher     *assist.Flusher
+	free        chan []Type
+	join        []Type
+	lastAt      time.Time
+	output      chan []Type
+	release     chan struct{}
+	sequence    uint64
+	timeout     *assist.Timeout
+	window      time.Time
 }
 
 // Creates and runs discipline.
@@ -91,25 +160,36 @@ func New[Type any](opts Opts[Type]) (*Discipline[Type], error) {
 
 	opts = opts.normalize()
 
-	interval, err := calcInterruptInterval(opts.Timeout, opts.TimeoutInaccuracy)
-	if err != nil {
+	// Inaccuracy is no longer used, but is validated for compatibility
+	if _, err := calcInterruptInterval(opts.Timeout, opts.TimeoutInaccuracy); err != nil {
 		return nil, err
 	}
 
 	dsc := &Discipline[Type]{
 		opts: opts,
 
-		interruptInterval: interval,
-		join:              make([]Type, 0, opts.JoinSize),
+		// Accumulated slice is the first buffer of the pool
+		allocated: 1,
+		done:      make(chan struct{}),
+		flusher:   assist.NewFlusher(),
+		free:      make(chan []Type, opts.PoolSize),
+		join:      make([]Type, 0, opts.JoinSize),
 		// Value returned by the cap() function is always positive and, in the case of
 		// integer overflow due to adding one, the resulting value can only become
 		// negative, which will cause a panic when executing make() as same as when
 		// specifying a large positive value
 		output:  make(chan []Type, 1+cap(opts.Input)),
 		release: make(chan struct{}),
+		timeout: assist.NewTimeout(opts.Clock, opts.Timeout, opts.TimeoutFromFirst),
 	}
 
-	dsc.resetPassAt()
+	// Only one of the output channels is used, so the other one is not buffered
+	if opts.Metadata {
+		dsc.batches = make(chan batch.Batch[Type], cap(dsc.output))
+		dsc.output = make(chan []Type)
+	} else {
+		dsc.batches = make(chan batch.Batch[Type])
+	}
 
 	go dsc.main()
 
@@ -123,18 +203,66 @@ func (dsc *Discipline[Type]) Output() <-chan []Type {
 	return dsc.output
 }
 
+// Returns output channel of the accumulated slices with metadata.
+//
+// Must be used only if Metadata option is set to true. In this case, nothing is
+// written to the channel returned by the Output() method.
+//
+// If this channel is closed, it means that the discipline is terminated.
+func (dsc *Discipline[Type]) Batches() <-chan batch.Batch[Type] {
+	return dsc.batches
+}
+
 // Marks accumulated slice as no longer used.
 //
-// Must be used only if NoCopy option is set to true.
+// Must be used only if NoCopy option is set to true and PoolSize option is not
+// specified.
 func (dsc *Discipline[Type]) Release() {
-	dsc.release <- struct{}{}
+	if dsc.opts.PoolSize != 0 {
+		return
+	}
+
+	select {
+	case dsc.release <- struct{}{}:
+	case <-dsc.done:
+	}
+}
+
+// Returns the slice received from the output channel to the pool of buffers.
+//
+// Must be used only if PoolSize option is specified. Each slice received from
+// the output channel must be returned exactly once.
+func (dsc *Discipline[Type]) Recycle(batch []Type) {
+	if dsc.opts.PoolSize == 0 {
+		return
+	}
+
+	// Pool has a capacity equal to the quantity of buffers, so writing to it
+	// blocks only if a slice is returned more than once
+	select {
+	case dsc.free <- batch[:0]:
+	case <-dsc.done:
+	}
+}
+
+// Writes the accumulated slice to the output channel without waiting for
+// the JoinSize to be reached or the timeout to expire and without closing
+// the input channel.
+//
+// Only data elements already received from the input channel are included in
+// the accumulated slice. Returns after the slice has been written to the output
+// channel, immediately if nothing has been accumulated, and also if
+// the discipline is terminated. Does not wait for the Release() method call.
+func (dsc *Discipline[Type]) Flush() {
+	dsc.flusher.Flush(dsc.done)
 }
 
 func (dsc *Discipline[Type]) main() {
+	defer close(dsc.done)
 	defer close(dsc.output)
-	defer close(dsc.release)
+	defer close(dsc.batches)
 
-	if dsc.interruptInterval == 0 {
+	if dsc.opts.Timeout <= 0 && dsc.opts.AlignInterval <= 0 {
 		dsc.loopUntimeouted()
 		return
 	}
@@ -143,38 +271,83 @@ func (dsc *Discipline[Type]) main() {
 }
 
 func (dsc *Discipline[Type]) loop() {
-	defer dsc.pass()
+	defer dsc.timeout.Stop()
+
+	// Alignment timer is created stopped and is started only when the accumulated
+	// slice becomes non-empty, so there are no interruptions while nothing is
+	// accumulated
+	if dsc.opts.AlignInterval > 0 {
+		dsc.alignTimer = dsc.opts.Clock.NewTimer(dsc.opts.AlignInterval)
+		dsc.alignTimer.Stop()
 
-	ticker := time.NewTicker(dsc.interruptInterval)
-	defer ticker.Stop()
+		defer dsc.alignTimer.Stop()
+	}
 
 	for {
 		select {
-		case <-ticker.C:
-			if dsc.isTimeouted() {
-				dsc.pass()
-			}
+		case <-dsc.opts.Context.Done():
+			dsc.pass(batch.ReasonCancel)
+			return
+		case flushed := <-dsc.flusher.Requests():
+			dsc.flusher.Accept(flushed)
+			dsc.pass(batch.ReasonFlush)
+		case <-dsc.timeout.C():
+			dsc.timeout.Expired()
+			dsc.pass(batch.ReasonTimeout)
+		case <-assist.TimerC(dsc.alignTimer):
+			dsc.alignActive = false
+			dsc.pass(batch.ReasonWindow)
 		case item, opened := <-dsc.opts.Input:
 			if !opened {
+				dsc.pass(batch.ReasonClose)
 				return
 			}
 
 			dsc.process(item)
+
+			if len(dsc.join) != 0 {
+				dsc.timeout.Start()
+			}
+
+			dsc.startAlignTimer()
 		}
 	}
 }
 
 func (dsc *Discipline[Type]) loopUntimeouted() {
-	defer dsc.pass()
+	for {
+		select {
+		case <-dsc.opts.Context.Done():
+			dsc.pass(batch.ReasonCancel)
+			return
+		case flushed := <-dsc.flusher.Requests():
+			dsc.flusher.Accept(flushed)
+			dsc.pass(batch.ReasonFlush)
+		case item, opened := <-dsc.opts.Input:
+			if !opened {
+				dsc.pass(batch.ReasonClose)
+				return
+			}
 
-	for item := range dsc.opts.Input {
-		dsc.process(item)
+			dsc.process(item)
+		}
 	}
 }
 
 func (dsc *Discipline[Type]) process(item []Type) {
 	if uint(len(item)) >= dsc.opts.JoinSize {
-		dsc.pass()
+		dsc.pass(batch.ReasonFull)
+		dsc.markReceived()
+
+		// Only buffers of the pool can be released into it, so oversized slice is
+		// copied into a buffer
+		if dsc.opts.PoolSize != 0 {
+			dsc.join = append(dsc.join, item...)
+			dsc.pass(batch.ReasonFull)
+
+			return
+		}
+
 		dsc.forward(item)
 
 		return
@@ -184,9 +357,10 @@ func (dsc *Discipline[Type]) process(item []Type) {
 	// values ​​for the int type and the sum of the two maximum values ​​for the int type is
 	// less than the maximum value for the uint type by one
 	if uint(len(item))+uint(len(dsc.join)) > dsc.opts.JoinSize {
-		dsc.pass()
+		dsc.pass(batch.ReasonFull)
 	}
 
+	dsc.markReceived()
 	dsc.join = append(dsc.join, item...)
 
 	// Integer overflow is impossible because len() function returns only positive
@@ -196,33 +370,95 @@ func (dsc *Discipline[Type]) process(item []Type) {
 		return
 	}
 
-	dsc.pass()
+	dsc.pass(batch.ReasonFull)
 }
 
-func (dsc *Discipline[Type]) pass() {
+func (dsc *Discipline[Type]) pass(reason batch.Reason) {
 	if len(dsc.join) == 0 {
 		// defer statement is not used to allow inlining of the current function
-		dsc.resetPassAt()
+		dsc.resetTimers()
+		dsc.flusher.Notify()
+
 		return
 	}
 
-	dsc.send(dsc.join)
+	dsc.send(dsc.join, reason)
 	dsc.resetJoin()
-	dsc.resetPassAt()
+	dsc.resetTimers()
 }
 
 func (dsc *Discipline[Type]) forward(item []Type) {
-	dsc.send(item)
-	dsc.resetPassAt()
+	dsc.send(item, batch.ReasonFull)
+	dsc.resetTimers()
 }
 
-func (dsc *Discipline[Type]) send(item []Type) {
+func (dsc *Discipline[Type]) send(item []Type, reason batch.Reason) {
 	item = dsc.prepareItem(item)
 
-	dsc.output <- item
+	dsc.write(item, reason)
+	dsc.flusher.Notify()
 
-	if dsc.opts.NoCopy {
-		<-dsc.release
+	if !dsc.opts.NoCopy {
+		return
+	}
+
+	if dsc.opts.PoolSize != 0 {
+		dsc.acquire()
+		return
+	}
+
+	if !assist.WaitRelease(dsc.opts.Context, dsc.release) {
+		dsc.join = nil
+	}
+}
+
+func (dsc *Discipline[Type]) write(item []Type, reason batch.Reason) {
+	if dsc.opts.Metadata {
+		assist.Deliver(dsc.batches, dsc.makeBatch(item, reason))
+		return
+	}
+
+	assist.Deliver(dsc.output, item)
+}
+
+func (dsc *Discipline[Type]) makeBatch(item []Type, reason batch.Reason) batch.Batch[Type] {
+	bth := batch.Batch[Type]{
+		FirstAt:  dsc.firstAt,
+		Items:    item,
+		LastAt:   dsc.lastAt,
+		Reason:   reason,
+		Sequence: dsc.sequence,
+		Window:   dsc.window,
+	}
+
+	dsc.sequence++
+
+	return bth
+}
+
+// Replaces the accumulated slice, written to the output channel, with a buffer
+// from the pool.
+func (dsc *Discipline[Type]) acquire() {
+	select {
+	case buffer := <-dsc.free:
+		dsc.join = buffer
+		return
+	default:
+	}
+
+	if dsc.allocated < dsc.opts.PoolSize {
+		dsc.join = make([]Type, 0, dsc.opts.JoinSize)
+		dsc.allocated++
+
+		return
+	}
+
+	select {
+	case buffer := <-dsc.free:
+		dsc.join = buffer
+	case <-dsc.opts.Context.Done():
+		// Accumulated slice is in use, so it must not be reused
+		dsc.join = nil
 	}
 }
 
@@ -234,14 +470,65 @@ func (dsc *Discipline[Type]) prepareItem(item []Type) []Type {
 	return slices.Clone(item)
 }
 
+// Remembers the time of receiving and the alignment window of the slice whose
+// elements are about to be added to the accumulated slice.
+func (dsc *Discipline[Type]) markReceived() {
+	if !dsc.opts.Metadata && dsc.opts.AlignInterval <= 0 {
+		return
+	}
+
+	now := dsc.opts.Clock.Now()
+
+	if dsc.opts.AlignInterval > 0 {
+		dsc.align(now)
+	}
+
+	if len(dsc.join) == 0 {
+		dsc.firstAt = now
+	}
+
+	dsc.lastAt = now
+}
+
 func (dsc *Discipline[Type]) resetJoin() {
 	dsc.join = dsc.join[:0]
 }
 
-func (dsc *Discipline[Type]) resetPassAt() {
-	dsc.passAt = time.Now()
+// Writes the accumulated slice to the output channel if the data element, that
+// is about to be added to it, is received in another alignment window, and
+// remembers the start time of this window.
+func (dsc *Discipline[Type]) align(now time.Time) {
+	window := now.Add(-dsc.opts.AlignOffset).Truncate(dsc.opts.AlignInterval)
+	window = window.Add(dsc.opts.AlignOffset)
+
+	if len(dsc.join) != 0 && !window.Equal(dsc.window) {
+		dsc.pass(batch.ReasonWindow)
+	}
+
+	dsc.window = window
+}
+
+func (dsc *Discipline[Type]) resetTimers() {
+	dsc.timeout.Pass()
+	dsc.stopAlignTimer()
+}
+
+// Starts the alignment timer when the accumulated slice becomes non-empty, so it
+// expires at the end of the window in which the first element was received.
+func (dsc *Discipline[Type]) startAlignTimer() {
+	if dsc.alignTimer == nil || dsc.alignActive || len(dsc.join) == 0 {
+		return
+	}
+
+	dsc.alignTimer.Reset(dsc.window.Add(dsc.opts.AlignInterval).Sub(dsc.opts.Clock.Now()))
+	dsc.alignActive = true
 }
 
-func (dsc *Discipline[Type]) isTimeouted() bool {
-	return time.Since(dsc.passAt) >= dsc.opts.Timeout
+func (dsc *Discipline[Type]) stopAlignTimer() {
+	if !dsc.alignActive {
+		return
+	}
+
+	dsc.alignTimer.Stop()
+	dsc.alignActive = false
 }
@@ -1,11 +1,14 @@
 package unite
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/akramarenkov/cqos/v2/clock"
+	"github.com/akramarenkov/cqos/v2/cqostest"
+	"github.com/akramarenkov/cqos/v2/join/batch"
 	"github.com/akramarenkov/cqos/v2/join/internal/defs"
-	"github.com/akramarenkov/cqos/v2/join/internal/inspect"
 
 	"github.com/stretchr/testify/require"
 )
@@ -50,6 +53,25 @@ func TestOptsValidation(t *testing.T) {
 
 	_, err = New(opts)
 	require.NoError(t, err)
+
+	opts = Opts[int]{
+		Input:    make(chan []int),
+		JoinSize: 10,
+		PoolSize: 2,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrPoolSizeWithoutNoCopy)
+
+	opts = Opts[int]{
+		Input:    make(chan []int),
+		JoinSize: 10,
+		NoCopy:   true,
+		PoolSize: 2,
+	}
+
+	_, err = New(opts)
+	require.NoError(t, err)
 }
 
 func TestDiscipline(t *testing.T) {
@@ -113,13 +135,13 @@ func testDiscipline(
 	inSequence := make([]int, 0, quantity)
 	outSequence := make([]int, 0, quantity)
 
-	expected := inspect.Expected(quantity, blockSize, joinSize)
+	expected := cqostest.Expected(quantity, blockSize, joinSize)
 	output := make([][]int, 0, len(expected))
 
 	go func() {
 		defer close(input)
 
-		for _, block := range inspect.Input(quantity, blockSize) {
+		for _, block := range cqostest.Input(quantity, blockSize) {
 			inSequence = append(inSequence, block...)
 
 			input <- block
@@ -229,7 +251,7 @@ func testDisciplineTimeout(
 
 	require.NotZero(t, timeout)
 
-	pauseAt = inspect.PickUpPauseAt(quantity, pauseAt, blockSize, joinSize)
+	pauseAt = cqostest.PickUpPauseAt(quantity, pauseAt, blockSize, joinSize)
 	require.NotZero(
 		t,
 		pauseAt,
@@ -243,7 +265,7 @@ func testDisciplineTimeout(
 		pauseAt,
 	)
 
-	pausetAtDuration := inspect.CalcPauseAtDuration(timeout)
+	pausetAtDuration := cqostest.CalcPauseAtDuration(timeout)
 
 	discipline, err := New(opts)
 	require.NoError(
@@ -262,13 +284,13 @@ func testDisciplineTimeout(
 	inSequence := make([]int, 0, quantity)
 	outSequence := make([]int, 0, quantity)
 
-	expected := inspect.ExpectedWithTimeout(quantity, pauseAt, blockSize, joinSize)
+	expected := cqostest.ExpectedWithTimeout(quantity, pauseAt, blockSize, joinSize)
 	output := make([][]int, 0, len(expected))
 
 	go func() {
 		defer close(input)
 
-		for _, block := range inspect.Input(quantity, blockSize) {
+		for _, block := range cqostest.Input(quantity, blockSize) {
 			for _, item := range block {
 				if item == pauseAt {
 					time.Sleep(pausetAtDuration)
@@ -447,68 +469,619 @@ func testDisciplineByDataSet(
 	)
 }
 
+func TestDisciplineClock(t *testing.T) {
+	testDisciplineClock(t, false)
+	testDisciplineClock(t, true)
+}
+
+func testDisciplineClock(t *testing.T, noCopy bool) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Clock:    manual,
+		Input:    input,
+		JoinSize: 5,
+		NoCopy:   noCopy,
+		Timeout:  time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- []int{1, 2}
+
+	// Waiting for the timer to be started by the first accumulated slice
+	manual.BlockUntil(1)
+
+	input <- []int{3}
+
+	manual.Advance(time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, []int{1, 2, 3}, <-discipline.Output())
+
+	// Timer is not started while nothing is accumulated
+	require.Equal(t, 0, manual.Blockers())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- []int{4, 5, 6}
+	input <- []int{7, 8}
+
+	require.Equal(t, []int{4, 5, 6, 7, 8}, <-discipline.Output())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- []int{9}
+
+	close(input)
+
+	require.Equal(t, []int{9}, <-discipline.Output())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineTimeoutFromFirst(t *testing.T) {
+	testDisciplineTimeoutFromFirst(t, false)
+	testDisciplineTimeoutFromFirst(t, true)
+}
+
+func testDisciplineTimeoutFromFirst(t *testing.T, fromFirst bool) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Clock:            manual,
+		Input:            input,
+		JoinSize:         3,
+		Timeout:          time.Second,
+		TimeoutFromFirst: fromFirst,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	manual.Advance(time.Second / 2)
+
+	input <- []int{1}
+
+	manual.BlockUntil(1)
+
+	// By default, the timeout is measured from the creation of the discipline,
+	// that is, from the last writing to the output channel
+	if !fromFirst {
+		manual.Advance(time.Second / 2)
+		require.Equal(t, []int{1}, <-discipline.Output())
+
+		close(input)
+
+		_, opened := <-discipline.Output()
+		require.False(t, opened)
+
+		return
+	}
+
+	manual.Advance(time.Second - 1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(1)
+	require.Equal(t, []int{1}, <-discipline.Output())
+
+	close(input)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineFlush(t *testing.T) {
+	testDisciplineFlush(t, false, 0)
+	testDisciplineFlush(t, true, 0)
+	testDisciplineFlush(t, false, defs.TestTimeout)
+	testDisciplineFlush(t, true, defs.TestTimeout)
+}
+
+func testDisciplineFlush(t *testing.T, noCopy bool, timeout time.Duration) {
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Input:    input,
+		JoinSize: 5,
+		NoCopy:   noCopy,
+		Timeout:  timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	// Nothing has been accumulated
+	discipline.Flush()
+	require.Empty(t, discipline.Output())
+
+	input <- []int{1, 2}
+	input <- []int{3}
+
+	discipline.Flush()
+	require.Len(t, discipline.Output(), 1)
+	require.Equal(t, []int{1, 2, 3}, <-discipline.Output())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- []int{4}
+
+	close(input)
+
+	require.Equal(t, []int{4}, <-discipline.Output())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	// Discipline is terminated
+	discipline.Flush()
+	discipline.Release()
+}
+
+func TestDisciplineContext(t *testing.T) {
+	testDisciplineContext(t, false, 0)
+	testDisciplineContext(t, true, 0)
+	testDisciplineContext(t, false, defs.TestTimeout)
+	testDisciplineContext(t, true, defs.TestTimeout)
+}
+
+func testDisciplineContext(t *testing.T, noCopy bool, timeout time.Duration) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Context:  ctx,
+		Input:    input,
+		JoinSize: 2,
+		NoCopy:   noCopy,
+		Timeout:  timeout,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- []int{1, 2}
+
+	// In NoCopy mode the discipline is blocked on waiting for the Release() method
+	// call. Otherwise, output channel has a capacity of one, so the discipline is
+	// blocked on writing the next slice
+	if !noCopy {
+		input <- []int{3, 4}
+	}
+
+	cancel()
+
+	require.Equal(t, []int{1, 2}, <-discipline.Output())
+
+	// Writing to the output channel is not interrupted, so the slice accumulated
+	// at the time of cancellation is delivered
+	if !noCopy {
+		require.Equal(t, []int{3, 4}, <-discipline.Output())
+	}
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	// Discipline is terminated
+	discipline.Release()
+	discipline.Flush()
+}
+
+func TestDisciplineContextAccumulated(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Context:  ctx,
+		Input:    input,
+		JoinSize: 5,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- []int{1, 2}
+
+	cancel()
+
+	// Accumulated slice is written at termination
+	require.Equal(t, []int{1, 2}, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplinePool(t *testing.T) {
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Input:    input,
+		JoinSize: 2,
+		NoCopy:   true,
+		PoolSize: 2,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- []int{1, 2}
+	first := <-discipline.Output()
+	require.Equal(t, []int{1, 2}, first)
+
+	// Discipline does not wait for the first slice to be released. Slices of
+	// the maximum size are copied into buffers of the pool
+	input <- []int{3, 4}
+	second := <-discipline.Output()
+	require.Equal(t, []int{3, 4}, second)
+
+	// All buffers of the pool are in use
+	select {
+	case input <- []int{5, 6}:
+		require.FailNow(t, "discipline does not wait for a buffer to be released")
+	default:
+	}
+
+	discipline.Recycle(first)
+
+	input <- []int{5, 6}
+	third := <-discipline.Output()
+	require.Equal(t, []int{5, 6}, third)
+
+	// Released buffer is reused
+	require.Equal(t, []int{5, 6}, first)
+
+	discipline.Recycle(second)
+	discipline.Recycle(third)
+
+	close(input)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+
+	// Discipline is terminated
+	discipline.Recycle(first)
+}
+
+func TestDisciplineAlign(t *testing.T) {
+	startedAt := time.Time{}
+
+	manual := clock.NewManual(startedAt)
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		AlignInterval: 10 * time.Second,
+		AlignOffset:   2 * time.Second,
+		Clock:         manual,
+		Input:         input,
+		JoinSize:      2,
+		Metadata:      true,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	manual.Advance(5 * time.Second)
+
+	input <- []int{1}
+
+	// Waiting for the alignment timer to be started by the first element
+	manual.BlockUntil(1)
+
+	manual.Advance(7*time.Second - 1)
+	require.Empty(t, discipline.Batches())
+
+	// Window is closed at the boundary regardless of the last writing
+	manual.Advance(1)
+
+	expected := batch.Batch[int]{
+		FirstAt:  startedAt.Add(5 * time.Second),
+		Items:    []int{1},
+		LastAt:   startedAt.Add(5 * time.Second),
+		Reason:   batch.ReasonWindow,
+		Sequence: 0,
+		Window:   startedAt.Add(2 * time.Second),
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	manual.Advance(time.Second)
+
+	input <- []int{2}
+	input <- []int{3}
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(13 * time.Second),
+		Items:    []int{2, 3},
+		LastAt:   startedAt.Add(13 * time.Second),
+		Reason:   batch.ReasonFull,
+		Sequence: 1,
+		Window:   startedAt.Add(12 * time.Second),
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	input <- []int{4}
+
+	manual.BlockUntil(1)
+	manual.Advance(9 * time.Second)
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(13 * time.Second),
+		Items:    []int{4},
+		LastAt:   startedAt.Add(13 * time.Second),
+		Reason:   batch.ReasonWindow,
+		Sequence: 2,
+		Window:   startedAt.Add(12 * time.Second),
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	input <- []int{5}
+
+	close(input)
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(22 * time.Second),
+		Items:    []int{5},
+		LastAt:   startedAt.Add(22 * time.Second),
+		Reason:   batch.ReasonClose,
+		Sequence: 3,
+		Window:   startedAt.Add(22 * time.Second),
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	_, opened := <-discipline.Batches()
+	require.False(t, opened)
+
+	require.Equal(t, 0, manual.Blockers())
+}
+
+func TestDisciplineMetadata(t *testing.T) {
+	testDisciplineMetadata(t, false)
+	testDisciplineMetadata(t, true)
+}
+
+func testDisciplineMetadata(t *testing.T, noCopy bool) {
+	startedAt := time.Time{}
+
+	manual := clock.NewManual(startedAt)
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Clock:    manual,
+		Input:    input,
+		JoinSize: 3,
+		Metadata: true,
+		NoCopy:   noCopy,
+		Timeout:  time.Second,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- []int{1}
+
+	// Waiting for the first slice to be accumulated
+	manual.BlockUntil(1)
+	manual.Advance(time.Second / 2)
+
+	input <- []int{2}
+
+	discipline.Flush()
+
+	expected := batch.Batch[int]{
+		FirstAt:  startedAt,
+		Items:    []int{1, 2},
+		LastAt:   startedAt.Add(time.Second / 2),
+		Reason:   batch.ReasonFlush,
+		Sequence: 0,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- []int{3}
+
+	manual.BlockUntil(1)
+	manual.Advance(time.Second)
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(time.Second / 2),
+		Items:    []int{3},
+		LastAt:   startedAt.Add(time.Second / 2),
+		Reason:   batch.ReasonTimeout,
+		Sequence: 1,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	// Slice of the maximum size is written to the output channel as is
+	input <- []int{4, 5, 6}
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(3 * time.Second / 2),
+		Items:    []int{4, 5, 6},
+		LastAt:   startedAt.Add(3 * time.Second / 2),
+		Reason:   batch.ReasonFull,
+		Sequence: 2,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	input <- []int{7}
+
+	close(input)
+
+	expected = batch.Batch[int]{
+		FirstAt:  startedAt.Add(3 * time.Second / 2),
+		Items:    []int{7},
+		LastAt:   startedAt.Add(3 * time.Second / 2),
+		Reason:   batch.ReasonClose,
+		Sequence: 3,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	if noCopy {
+		discipline.Release()
+	}
+
+	_, opened := <-discipline.Batches()
+	require.False(t, opened)
+
+	_, opened = <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineMetadataCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	startedAt := time.Time{}
+
+	input := make(chan []int)
+
+	opts := Opts[int]{
+		Clock:    clock.NewManual(startedAt),
+		Context:  ctx,
+		Input:    input,
+		JoinSize: 3,
+		Metadata: true,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- []int{1}
+
+	cancel()
+
+	// Returns when the discipline is terminated
+	discipline.Flush()
+
+	expected := batch.Batch[int]{
+		FirstAt:  startedAt,
+		Items:    []int{1},
+		LastAt:   startedAt,
+		Reason:   batch.ReasonCancel,
+		Sequence: 0,
+	}
+
+	require.Equal(t, expected, <-discipline.Batches())
+
+	_, opened := <-discipline.Batches()
+	require.False(t, opened)
+}
+
 func BenchmarkDiscipline(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, false, defs.TestTimeout, 1)
+	benchmarkDiscipline(b, 10, 4, false, defs.TestTimeout, 1, 0)
 }
 
 func BenchmarkDisciplineNoCopy(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, true, defs.TestTimeout, 1)
+	benchmarkDiscipline(b, 10, 4, true, defs.TestTimeout, 1, 0)
 }
 
 func BenchmarkDisciplineUntimeouted(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, false, 0, 1)
+	benchmarkDiscipline(b, 10, 4, false, 0, 1, 0)
 }
 
 func BenchmarkDisciplineNoCopyUntimeouted(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, true, 0, 1)
+	benchmarkDiscipline(b, 10, 4, true, 0, 1, 0)
+}
+
+func BenchmarkDisciplinePool(b *testing.B) {
+	benchmarkDiscipline(b, 10, 4, true, defs.TestTimeout, 1, 4)
+}
+
+func BenchmarkDisciplinePoolUntimeouted(b *testing.B) {
+	benchmarkDiscipline(b, 10, 4, true, 0, 1, 4)
 }
 
 func BenchmarkDisciplineInputCapacity0(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, false, 0, 0)
+	benchmarkDiscipline(b, 10, 4, false, 0, 0, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity0(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, true, 0, 0)
+	benchmarkDiscipline(b, 10, 4, true, 0, 0, 0)
 }
 
 func BenchmarkDisciplineInputCapacity50(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, false, 0, 0.5)
+	benchmarkDiscipline(b, 10, 4, false, 0, 0.5, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity50(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, true, 0, 0.5)
+	benchmarkDiscipline(b, 10, 4, true, 0, 0.5, 0)
 }
 
 func BenchmarkDisciplineInputCapacity100(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, false, 0, 1)
+	benchmarkDiscipline(b, 10, 4, false, 0, 1, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity100(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, true, 0, 1)
+	benchmarkDiscipline(b, 10, 4, true, 0, 1, 0)
 }
 
 func BenchmarkDisciplineInputCapacity200(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, false, 0, 2)
+	benchmarkDiscipline(b, 10, 4, false, 0, 2, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity200(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, true, 0, 2)
+	benchmarkDiscipline(b, 10, 4, true, 0, 2, 0)
 }
 
 func BenchmarkDisciplineInputCapacity300(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, false, 0, 3)
+	benchmarkDiscipline(b, 10, 4, false, 0, 3, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity300(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, true, 0, 3)
+	benchmarkDiscipline(b, 10, 4, true, 0, 3, 0)
 }
 
 func BenchmarkDisciplineInputCapacity400(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, false, 0, 4)
+	benchmarkDiscipline(b, 10, 4, false, 0, 4, 0)
 }
 
 func BenchmarkDisciplineNoCopyInputCapacity400(b *testing.B) {
-	benchmarkDiscipline(b, 10, 4, true, 0, 4)
+	benchmarkDiscipline(b, 10, 4, true, 0, 4, 0)
 }
 
 func benchmarkDiscipline(
@@ -518,6 +1091,7 @@ func benchmarkDiscipline(
 	noCopy bool,
 	timeout time.Duration,
 	inputCapFactor float64,
+	poolSize uint,
 ) {
 	joinsQuantity := b.N
 	effectiveJoinSize := blockSize * (int(joinSize) / blockSize)
@@ -530,13 +1104,14 @@ func benchmarkDiscipline(
 		Input:    input,
 		JoinSize: joinSize,
 		NoCopy:   noCopy,
+		PoolSize: poolSize,
 		Timeout:  timeout,
 	}
 
 	discipline, err := New(opts)
 	require.NoError(b, err)
 
-	blocks := inspect.Input(quantity, blockSize)
+	blocks := cqostest.Input(quantity, blockSize)
 
 	b.ResetTimer()
 
@@ -548,7 +1123,12 @@ func benchmarkDiscipline(
 		}
 	}()
 
-	for range discipline.Output() {
+	for join := range discipline.Output() {
+		if poolSize != 0 {
+			discipline.Recycle(join)
+			continue
+		}
+
 		if noCopy {
 			discipline.Release()
 		}
@@ -0,0 +1,105 @@
+package limit
+
+import (
+	"time"
+)
+
+// In the token bucket mode, each data element passed to the output channel
+// consumes a token or, if the Cost function is specified, the quantity of tokens
+// equal to its cost. Tokens are added to the bucket in the quantity of
+// the Quantity field of the rate limit structure every time Interval, while
+// the bucket is not full, including the time when there are no data elements
+// in the input channel.
+func (dsc *Discipline[Type]) loopBucket() {
+	dsc.tokens = dsc.opts.Burst
+	dsc.refilledAt = dsc.opts.Clock.Now()
+
+	for item := range dsc.opts.Input {
+		dsc.acquireTokens(dsc.cost(item))
+		dsc.send(item)
+	}
+}
+
+func (dsc *Discipline[Type]) cost(item Type) uint64 {
+	if dsc.opts.Cost == nil {
+		return 1
+	}
+
+	return dsc.opts.Cost(item)
+}
+
+// Waits for the tokens to appear in the bucket and consumes them. If the quantity
+// of tokens exceeds the Burst, it waits for the bucket to fill and the excess is
+// carried as a debt.
+func (dsc *Discipline[Type]) acquireTokens(quantity uint64) {
+	required := min(quantity, dsc.opts.Burst)
+
+	dsc.refill()
+	dsc.applyRate()
+
+	for dsc.debt != 0 || dsc.tokens < required {
+		wait := dsc.opts.Limit.Interval - dsc.opts.Clock.Since(dsc.refilledAt)
+
+		dsc.opts.Clock.Sleep(wait)
+		dsc.refill()
+		dsc.applyRate()
+	}
+
+	if quantity <= dsc.tokens {
+		dsc.tokens -= quantity
+		return
+	}
+
+	dsc.debt = quantity - dsc.tokens
+	dsc.tokens = 0
+}
+
+// Adds to the bucket the tokens accumulated since the last refilling. The debt is
+// paid off first.
+func (dsc *Discipline[Type]) refill() {
+	// This duration is the time difference of monotonic clock, so it is always
+	// at least non-negative
+	elapsed := dsc.opts.Clock.Since(dsc.refilledAt)
+
+	// Conversion is safe because the quotient of dividing a non-negative duration
+	// by a positive one is non-negative
+	intervals := uint64(elapsed / dsc.opts.Limit.Interval)
+
+	if intervals == 0 {
+		return
+	}
+
+	// Quantity of intervals required to fill the bucket is calculated to avoid
+	// integer overflow when multiplying the quantity of intervals by the Quantity
+	// field of the rate limit structure
+	missing := addSaturated(dsc.opts.Burst-dsc.tokens, dsc.debt)
+	required := missing / dsc.opts.Limit.Quantity
+
+	if missing%dsc.opts.Limit.Quantity != 0 {
+		required++
+	}
+
+	if intervals >= required {
+		// Tokens do not accumulate in the full bucket, so the refilling starts over
+		dsc.tokens = dsc.opts.Burst
+		dsc.debt = 0
+		dsc.refilledAt = dsc.opts.Clock.Now()
+
+		return
+	}
+
+	// Integer overflow is impossible because the added quantity is less than
+	// the missing one
+	added := intervals * dsc.opts.Limit.Quantity
+
+	if added <= dsc.debt {
+		dsc.debt -= added
+	} else {
+		dsc.tokens += added - dsc.debt
+		dsc.debt = 0
+	}
+
+	// Integer overflow is impossible because the quantity of intervals is obtained
+	// by dividing a duration by the Interval field of the rate limit structure
+	dsc.refilledAt = dsc.refilledAt.Add(time.Duration(intervals) * dsc.opts.Limit.Interval)
+}
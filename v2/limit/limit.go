@@ -4,15 +4,51 @@ package limit
 
 import (
 	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/akramarenkov/cqos/v2/clock"
 )
 
 var (
-	ErrInputEmpty = errors.New("input channel was not specified")
+	ErrBurstTooSmall    = errors.New("burst is less than quantity")
+	ErrBurstWithSliding = errors.New("burst is specified in sliding window mode")
+	ErrInputEmpty       = errors.New("input channel was not specified")
 )
 
 // Options of the created discipline.
 type Opts[Type any] struct {
+	// Capacity of the token bucket. If it is specified, then the discipline works in
+	// the token bucket mode: tokens are accumulated, including while there are no
+	// data elements in the input channel, in the quantity of the Quantity field of
+	// the rate limit structure every time Interval up to the Burst, and data
+	// elements are passed immediately while there are tokens. Thus, after an idle
+	// period, up to Burst data elements can be passed without delay. Cannot be less
+	// than the Quantity field of the rate limit structure, which, as well as
+	// the Interval field, sets the granularity of the refilling and can be chosen
+	// using the Flatten() and Optimize() methods. Initially the bucket is full
+	Burst uint64
+	// Returns the cost of a data element, for example, the quantity of records
+	// carried by it. If it is specified, then the rate limit is measured in cost
+	// units instead of data elements. A data element whose cost exceeds the rest of
+	// the budget of the time interval is still passed and the excess is carried as
+	// a debt into the following time intervals, in which, until it is paid off,
+	// data elements are not passed. Data elements with zero cost do not consume
+	// the budget, but since the order of data elements is preserved, they are not
+	// passed, like any others, while the debt is being paid off.
+	// In the token bucket mode, a data element consumes the quantity of tokens
+	// equal to its cost and waits for them to accumulate, but if its cost exceeds
+	// the Burst, it waits only for the bucket to fill and the excess is carried as
+	// a debt that is paid off before the bucket is refilled. In the sliding window
+	// mode, a data element whose cost exceeds the Quantity field of the rate limit
+	// structure waits for the window to become empty and then occupies it for as
+	// many time intervals as are required to pay off its cost
+	Cost func(Type) uint64
+	// Source of the current time and delays. By default, the wall clock is used.
+	// Can be replaced with the clock.Manual for deterministic testing
+	Clock clock.Clock
 	// Input data channel. For terminate discipline it is necessary and sufficient to
 	// close the input channel. Preferably input channel should be buffered for
 	// performance reasons. Optimal capacity is in the range of 1e2 to 1e6 and
@@ -22,10 +58,21 @@ type Opts[Type any] struct {
 	// is closed is a multiple of the Quantity field in the rate limit structure, the
 	// discipline will still perform a delay after the last data element is transmitted.
 	// This, with large values ​​of the Interval field in the rate limit structure, will
-	// result in a long discipline completion time
+	// result in a long discipline completion time. This does not apply to the token
+	// bucket and sliding window modes
 	Input <-chan Type
-	// Rate limit
+	// Rate limit. It can be changed while the discipline is running by
+	// the SetRate() method
 	Limit Rate
+	// Enables the sliding window mode, in which no more than Quantity data elements
+	// are passed within any time interval of length Interval, while by default
+	// the limit is applied to consecutive time intervals and up to twice as many
+	// data elements can be passed within a time interval that crosses the boundary
+	// of two consecutive ones. In this mode, the time of passing of each data
+	// element within the last time Interval is kept in memory, so the Flatten() and
+	// Optimize() methods can be used to reduce memory consumption at the cost of
+	// a stricter limit. Cannot be used together with the Burst
+	Sliding bool
 }
 
 func (opts Opts[Type]) isValid() error {
@@ -33,14 +80,43 @@ func (opts Opts[Type]) isValid() error {
 		return ErrInputEmpty
 	}
 
-	return opts.Limit.IsValid()
+	if err := opts.Limit.IsValid(); err != nil {
+		return err
+	}
+
+	if opts.Burst != 0 && opts.Burst < opts.Limit.Quantity {
+		return ErrBurstTooSmall
+	}
+
+	if opts.Burst != 0 && opts.Sliding {
+		return ErrBurstWithSliding
+	}
+
+	return nil
+}
+
+func (opts Opts[Type]) normalize() Opts[Type] {
+	if opts.Clock == nil {
+		opts.Clock = clock.Real{}
+	}
+
+	return opts
 }
 
 // Limit discipline.
 type Discipline[Type any] struct {
 	opts Opts[Type]
 
-	output chan Type
+	changed    atomic.Bool
+	debt       uint64
+	log        []passage
+	mutex      *sync.Mutex
+	output     chan Type
+	rate       Rate
+	refilledAt time.Time
+	spent      uint64
+	startedAt  time.Time
+	tokens     uint64
 }
 
 // Creates and runs discipline.
@@ -49,9 +125,12 @@ func New[Type any](opts Opts[Type]) (*Discipline[Type], error) {
 		return nil, err
 	}
 
+	opts = opts.normalize()
+
 	dsc := &Discipline[Type]{
 		opts: opts,
 
+		mutex: &sync.Mutex{},
 		// Value returned by the cap() function is always positive and, in the case of
 		// integer overflow due to adding one, the resulting value can only become
 		// negative, which will cause a panic when executing make() as same as when
@@ -71,14 +150,90 @@ func (dsc *Discipline[Type]) Output() <-chan Type {
 	return dsc.output
 }
 
+// Changes the rate limit of the running discipline.
+//
+// The new rate limit is applied at the end of the current time interval, and if
+// the discipline waits for data elements after the current time interval has
+// ended, a new time interval with the new rate limit begins when the next data
+// element is received. In the token bucket mode it is applied at the next refilling of
+// the bucket, that is, the tokens accumulated before it are calculated according
+// to the current rate limit. In the sliding window mode, the new rate limit is
+// applied before passing the next data element, but the data elements passed
+// before it occupy the window for the Interval of the current rate limit.
+//
+// Can be called from any goroutine.
+func (dsc *Discipline[Type]) SetRate(limit Rate) error {
+	if err := limit.IsValid(); err != nil {
+		return err
+	}
+
+	if dsc.opts.Burst != 0 && dsc.opts.Burst < limit.Quantity {
+		return ErrBurstTooSmall
+	}
+
+	dsc.mutex.Lock()
+	defer dsc.mutex.Unlock()
+
+	dsc.rate = limit
+	dsc.changed.Store(true)
+
+	return nil
+}
+
+// Applies the rate limit specified by the SetRate() method, if any.
+func (dsc *Discipline[Type]) applyRate() {
+	// Flag is checked without locking the mutex because this is done for each data
+	// element
+	if !dsc.changed.Load() {
+		return
+	}
+
+	dsc.mutex.Lock()
+	defer dsc.mutex.Unlock()
+
+	dsc.opts.Limit = dsc.rate
+	dsc.changed.Store(false)
+}
+
+// Begins a new time interval with the rate limit specified by the SetRate()
+// method, if any, when the current time interval has ended while the discipline
+// was waiting for data elements. Returns true if a new time interval has begun.
+func (dsc *Discipline[Type]) renew() bool {
+	if !dsc.changed.Load() {
+		return false
+	}
+
+	if dsc.opts.Clock.Since(dsc.startedAt) < dsc.opts.Limit.Interval {
+		return false
+	}
+
+	dsc.applyRate()
+
+	dsc.startedAt = dsc.opts.Clock.Now()
+
+	return true
+}
+
 func (dsc *Discipline[Type]) main() {
 	defer close(dsc.output)
 
+	if dsc.opts.Burst != 0 {
+		dsc.loopBucket()
+		return
+	}
+
+	if dsc.opts.Sliding {
+		dsc.loopSliding()
+		return
+	}
+
 	dsc.loop()
 }
 
 func (dsc *Discipline[Type]) loop() {
 	for {
+		dsc.applyRate()
+
 		duration, stop := dsc.transfer()
 		if stop {
 			return
@@ -89,7 +244,7 @@ func (dsc *Discipline[Type]) loop() {
 }
 
 func (dsc *Discipline[Type]) transfer() (time.Duration, bool) {
-	startedAt := time.Now()
+	dsc.startedAt = dsc.opts.Clock.Now()
 
 	if stop := dsc.pass(); stop {
 		return 0, true
@@ -97,22 +252,62 @@ func (dsc *Discipline[Type]) transfer() (time.Duration, bool) {
 
 	// This duration is the time difference of monotonic clock, so it is always
 	// at least non-negative
-	return time.Since(startedAt), false
+	return dsc.opts.Clock.Since(dsc.startedAt), false
 }
 
 func (dsc *Discipline[Type]) pass() bool {
-	for range dsc.opts.Limit.Quantity {
+	if dsc.opts.Cost != nil {
+		return dsc.passCosted()
+	}
+
+	for passed := uint64(0); passed < dsc.opts.Limit.Quantity; passed++ {
 		item, opened := <-dsc.opts.Input
 		if !opened {
 			return true
 		}
 
+		// Data element is the first one in the new time interval
+		if dsc.renew() {
+			passed = 0
+		}
+
 		dsc.send(item)
 	}
 
 	return false
 }
 
+func (dsc *Discipline[Type]) passCosted() bool {
+	// Debt of the previous time intervals is paid off by the budget of the current
+	// one
+	if dsc.debt >= dsc.opts.Limit.Quantity {
+		dsc.debt -= dsc.opts.Limit.Quantity
+		return false
+	}
+
+	spent := dsc.debt
+
+	for spent < dsc.opts.Limit.Quantity {
+		item, opened := <-dsc.opts.Input
+		if !opened {
+			return true
+		}
+
+		// Debt has been paid off by the budget of the ended time interval
+		if dsc.renew() {
+			spent = 0
+		}
+
+		spent = addSaturated(spent, dsc.opts.Cost(item))
+
+		dsc.send(item)
+	}
+
+	dsc.debt = spent - dsc.opts.Limit.Quantity
+
+	return false
+}
+
 func (dsc *Discipline[Type]) send(item Type) {
 	dsc.output <- item
 }
@@ -122,5 +317,15 @@ func (dsc *Discipline[Type]) delay(duration time.Duration) {
 	// structure and transfer duration are greater than zero
 	remainder := dsc.opts.Limit.Interval - duration
 
-	time.Sleep(remainder)
+	dsc.opts.Clock.Sleep(remainder)
+}
+
+// Returns the sum of the values or the maximum value of the uint64 type in case of
+// integer overflow.
+func addSaturated(first uint64, second uint64) uint64 {
+	if second > math.MaxUint64-first {
+		return math.MaxUint64
+	}
+
+	return first + second
 }
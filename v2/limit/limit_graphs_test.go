@@ -7,8 +7,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/akramarenkov/cqos/v2/analysis"
 	"github.com/akramarenkov/cqos/v2/internal/env"
-	"github.com/akramarenkov/cqos/v2/limit/internal/research"
 
 	"github.com/akramarenkov/stressor"
 	"github.com/go-echarts/go-echarts/v2/charts"
@@ -123,9 +123,9 @@ func createDelayerQuantitiesGraph(
 	duration time.Duration,
 	stressSystem bool,
 ) {
-	quantities, calcInterval := research.CalcIntervalQuantities(relativeTimes, 0, duration)
+	quantities, calcInterval := analysis.CalcIntervalQuantities(relativeTimes, 0, duration)
 
-	axisY, axisX := research.ConvertQuantityOverTimeToBarEcharts(quantities)
+	axisY, axisX := analysis.ConvertQuantityOverTimeToBarEcharts(quantities)
 
 	expectedDuration := time.Duration(len(relativeTimes)) * duration
 
@@ -158,9 +158,9 @@ func createDelayerDeviationsGraph(
 	duration time.Duration,
 	stressSystem bool,
 ) {
-	deviations := research.CalcRelativeDeviations(relativeTimes, duration)
+	deviations := analysis.CalcRelativeDeviations(relativeTimes, duration)
 
-	axisY, axisX := research.ConvertRelativeDeviationsToBarEcharts(deviations)
+	axisY, axisX := analysis.ConvertRelativeDeviationsToBarEcharts(deviations)
 
 	subtitleAdd := fmt.Sprintf(
 		"duration: %s",
@@ -192,6 +192,119 @@ func TestGraphDiscipline(t *testing.T) {
 			Quantity: 1e3,
 		},
 		false,
+		false,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e4+1,
+		Rate{
+			Interval: 100 * time.Millisecond,
+			Quantity: 1e2,
+		},
+		false,
+		false,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e4+1,
+		Rate{
+			Interval: 10 * time.Millisecond,
+			Quantity: 1e1,
+		},
+		false,
+		false,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e5+1,
+		Rate{
+			Interval: time.Millisecond,
+			Quantity: 1e1,
+		},
+		false,
+		false,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e4+1,
+		Rate{
+			Interval: time.Nanosecond,
+			Quantity: 1,
+		},
+		false,
+		false,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e4+1,
+		Rate{
+			Interval: time.Second,
+			Quantity: 1e3,
+		},
+		false,
+		true,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e4+1,
+		Rate{
+			Interval: 100 * time.Millisecond,
+			Quantity: 1e2,
+		},
+		false,
+		true,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e4+1,
+		Rate{
+			Interval: 10 * time.Millisecond,
+			Quantity: 1e1,
+		},
+		false,
+		true,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e5+1,
+		Rate{
+			Interval: time.Millisecond,
+			Quantity: 1e1,
+		},
+		false,
+		true,
+	)
+
+	testGraphDiscipline(
+		t,
+		1e4+1,
+		Rate{
+			Interval: time.Nanosecond,
+			Quantity: 1,
+		},
+		false,
+		true,
+	)
+}
+
+func TestGraphDisciplineSliding(t *testing.T) {
+	testGraphDiscipline(
+		t,
+		1e4+1,
+		Rate{
+			Interval: time.Second,
+			Quantity: 1e3,
+		},
+		true,
+		false,
 	)
 
 	testGraphDiscipline(
@@ -201,6 +314,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Interval: 100 * time.Millisecond,
 			Quantity: 1e2,
 		},
+		true,
 		false,
 	)
 
@@ -211,6 +325,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Interval: 10 * time.Millisecond,
 			Quantity: 1e1,
 		},
+		true,
 		false,
 	)
 
@@ -221,6 +336,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Interval: time.Millisecond,
 			Quantity: 1e1,
 		},
+		true,
 		false,
 	)
 
@@ -231,6 +347,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Interval: time.Nanosecond,
 			Quantity: 1,
 		},
+		true,
 		false,
 	)
 
@@ -242,6 +359,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Quantity: 1e3,
 		},
 		true,
+		true,
 	)
 
 	testGraphDiscipline(
@@ -252,6 +370,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Quantity: 1e2,
 		},
 		true,
+		true,
 	)
 
 	testGraphDiscipline(
@@ -262,6 +381,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Quantity: 1e1,
 		},
 		true,
+		true,
 	)
 
 	testGraphDiscipline(
@@ -272,6 +392,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Quantity: 1e1,
 		},
 		true,
+		true,
 	)
 
 	testGraphDiscipline(
@@ -282,6 +403,7 @@ func TestGraphDiscipline(t *testing.T) {
 			Quantity: 1,
 		},
 		true,
+		true,
 	)
 }
 
@@ -289,6 +411,7 @@ func testGraphDiscipline(
 	t *testing.T,
 	quantity int,
 	limit Rate,
+	sliding bool,
 	stressSystem bool,
 ) {
 	if os.Getenv(env.EnableGraphs) == "" {
@@ -305,8 +428,9 @@ func testGraphDiscipline(
 	input := make(chan int, quantity)
 
 	opts := Opts[int]{
-		Input: input,
-		Limit: limit,
+		Input:   input,
+		Limit:   limit,
+		Sliding: sliding,
 	}
 
 	discipline, err := New(opts)
@@ -328,34 +452,247 @@ func testGraphDiscipline(
 		relativeTimes = append(relativeTimes, time.Since(startedAt))
 	}
 
-	createQuantitiesGraph(t, relativeTimes, limit, stressSystem)
-	createDeviationsGraph(t, relativeTimes, limit, stressSystem)
+	createQuantitiesGraph(t, relativeTimes, limit, sliding, stressSystem)
+	createDeviationsGraph(t, relativeTimes, limit, sliding, stressSystem)
+}
+
+func TestGraphDisciplineWindow(t *testing.T) {
+	testGraphDisciplineWindow(
+		t,
+		1e4+1,
+		Rate{
+			Interval: time.Second,
+			Quantity: 1e3,
+		},
+		false,
+	)
+
+	testGraphDisciplineWindow(
+		t,
+		1e4+1,
+		Rate{
+			Interval: 100 * time.Millisecond,
+			Quantity: 1e2,
+		},
+		false,
+	)
+
+	testGraphDisciplineWindow(
+		t,
+		1e4+1,
+		Rate{
+			Interval: 10 * time.Millisecond,
+			Quantity: 1e1,
+		},
+		false,
+	)
+
+	testGraphDisciplineWindow(
+		t,
+		1e4+1,
+		Rate{
+			Interval: time.Second,
+			Quantity: 1e3,
+		},
+		true,
+	)
+
+	testGraphDisciplineWindow(
+		t,
+		1e4+1,
+		Rate{
+			Interval: 100 * time.Millisecond,
+			Quantity: 1e2,
+		},
+		true,
+	)
+
+	testGraphDisciplineWindow(
+		t,
+		1e4+1,
+		Rate{
+			Interval: 10 * time.Millisecond,
+			Quantity: 1e1,
+		},
+		true,
+	)
+}
+
+// Compares the quantities of data elements passed within the window of length
+// Interval in the default and sliding window modes. Data elements are written to
+// the input channel in bursts of half the Quantity slightly more often than twice
+// per Interval, so in the default mode the budget of a time interval is spent by
+// its end and the budget of the next one is spent immediately at its beginning.
+func testGraphDisciplineWindow(
+	t *testing.T,
+	quantity int,
+	limit Rate,
+	stressSystem bool,
+) {
+	if os.Getenv(env.EnableGraphs) == "" {
+		t.SkipNow()
+	}
+
+	if stressSystem {
+		stress := stressor.New(stressor.Opts{})
+		defer stress.Stop()
+
+		time.Sleep(time.Second)
+	}
+
+	for _, sliding := range []bool{false, true} {
+		relativeTimes := runDisciplineBursty(t, quantity, limit, sliding)
+
+		createWindowQuantitiesGraph(t, relativeTimes, limit, sliding, stressSystem)
+	}
+}
+
+func runDisciplineBursty(
+	t *testing.T,
+	quantity int,
+	limit Rate,
+	sliding bool,
+) []time.Duration {
+	burst := int(limit.Quantity / 2)
+	pause := 45 * limit.Interval / 100
+
+	input := make(chan int, quantity)
+
+	opts := Opts[int]{
+		Input:   input,
+		Limit:   limit,
+		Sliding: sliding,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	relativeTimes := make([]time.Duration, 0, quantity)
+
+	startedAt := time.Now()
+
+	go func() {
+		defer close(input)
+
+		for item := range quantity {
+			if item != 0 && item%burst == 0 {
+				time.Sleep(pause)
+			}
+
+			input <- item
+		}
+	}()
+
+	for range discipline.Output() {
+		relativeTimes = append(relativeTimes, time.Since(startedAt))
+	}
+
+	return relativeTimes
+}
+
+func createWindowQuantitiesGraph(
+	t *testing.T,
+	relativeTimes []time.Duration,
+	limit Rate,
+	sliding bool,
+	stressSystem bool,
+) {
+	quantities, maxQuantity := calcWindowQuantities(relativeTimes, limit.Interval)
+
+	axisY, axisX := analysis.ConvertQuantityOverTimeToBarEcharts(quantities)
+
+	subtitleAdd := fmt.Sprintf(
+		"limit: {quantity: %d, interval: %s}, "+
+			"sliding: %t, "+
+			"max within window: %d",
+		limit.Quantity,
+		limit.Interval,
+		sliding,
+		maxQuantity,
+	)
+
+	fileNameAdd := "window_" +
+		"limit_quantity_" +
+		strconv.Itoa(int(limit.Quantity)) +
+		"_limit_interval_" +
+		limit.Interval.String() +
+		"_sliding_" +
+		strconv.FormatBool(sliding)
+
+	createGraph(
+		t,
+		"Quantities within the window",
+		subtitleAdd,
+		fileNameAdd,
+		"quantities",
+		len(relativeTimes),
+		limit.Interval.String(),
+		stressSystem,
+		axisY,
+		axisX,
+	)
+}
+
+// Calculates for each relative time the quantity of relative times that fall
+// into the window of the specified length ending with it. Also returns
+// the maximum of the calculated quantities.
+//
+// Relative times must be sorted.
+func calcWindowQuantities(
+	relativeTimes []time.Duration,
+	window time.Duration,
+) ([]analysis.QOT, uint) {
+	quantities := make([]analysis.QOT, 0, len(relativeTimes))
+	maxQuantity := uint(0)
+
+	begin := 0
+
+	for id, relativeTime := range relativeTimes {
+		for relativeTime-relativeTimes[begin] >= window {
+			begin++
+		}
+
+		item := analysis.QOT{
+			Quantity:     uint(id - begin + 1),
+			RelativeTime: relativeTime,
+		}
+
+		quantities = append(quantities, item)
+		maxQuantity = max(maxQuantity, item.Quantity)
+	}
+
+	return quantities, maxQuantity
 }
 
 func createQuantitiesGraph(
 	t *testing.T,
 	relativeTimes []time.Duration,
 	limit Rate,
+	sliding bool,
 	stressSystem bool,
 ) {
-	quantities, calcInterval := research.CalcIntervalQuantities(relativeTimes, 100, 0)
+	quantities, calcInterval := analysis.CalcIntervalQuantities(relativeTimes, 100, 0)
 
-	axisY, axisX := research.ConvertQuantityOverTimeToBarEcharts(quantities)
+	axisY, axisX := analysis.ConvertQuantityOverTimeToBarEcharts(quantities)
 
 	expectedDuration := (time.Duration(len(relativeTimes)) * limit.Interval) / time.Duration(limit.Quantity)
 
 	subtitleAdd := fmt.Sprintf(
 		"limit: {quantity: %d, interval: %s}, "+
+			"sliding: %t, "+
 			formatTotalDuration(expectedDuration, relativeTimes),
 		limit.Quantity,
 		limit.Interval,
+		sliding,
 	)
 
 	fileNameAdd := "quantities_" +
 		"limit_quantity_" +
 		strconv.Itoa(int(limit.Quantity)) +
 		"_limit_interval_" +
-		limit.Interval.String()
+		limit.Interval.String() +
+		"_sliding_" +
+		strconv.FormatBool(sliding)
 
 	createGraph(
 		t,
@@ -375,29 +712,34 @@ func createDeviationsGraph(
 	t *testing.T,
 	relativeTimes []time.Duration,
 	limit Rate,
+	sliding bool,
 	stressSystem bool,
 ) {
 	flatten, err := limit.Flatten()
 	require.NoError(t, err)
 
-	deviations := research.CalcRelativeDeviations(relativeTimes, flatten.Interval)
+	deviations := analysis.CalcRelativeDeviations(relativeTimes, flatten.Interval)
 
-	axisY, axisX := research.ConvertRelativeDeviationsToBarEcharts(deviations)
+	axisY, axisX := analysis.ConvertRelativeDeviationsToBarEcharts(deviations)
 
 	subtitleAdd := fmt.Sprintf(
 		"limit: {quantity: %d, interval: %s}, "+
-			"flatten: {quantity: %d, interval: %s}",
+			"flatten: {quantity: %d, interval: %s}, "+
+			"sliding: %t",
 		limit.Quantity,
 		limit.Interval,
 		flatten.Quantity,
 		flatten.Interval,
+		sliding,
 	)
 
 	fileNameAdd := "deviations_" +
 		"limit_quantity_" +
 		strconv.Itoa(int(limit.Quantity)) +
 		"_limit_interval_" +
-		limit.Interval.String()
+		limit.Interval.String() +
+		"_sliding_" +
+		strconv.FormatBool(sliding)
 
 	createGraph(
 		t,
@@ -495,7 +837,7 @@ func formatTotalDuration(expected time.Duration, relativeTimes []time.Duration)
 	out := fmt.Sprintf(
 		"total duration: {expected:  %s, actual: %s}",
 		expected,
-		research.CalcTotalDuration(relativeTimes),
+		analysis.CalcTotalDuration(relativeTimes),
 	)
 
 	return out
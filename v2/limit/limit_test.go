@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/akramarenkov/cqos/v2/clock"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,6 +22,31 @@ func TestOptsValidation(t *testing.T) {
 	_, err = New(opts)
 	require.Error(t, err)
 
+	opts = Opts[int]{
+		Burst: 1,
+		Input: make(chan int),
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 2,
+		},
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrBurstTooSmall)
+
+	opts = Opts[int]{
+		Burst: 2,
+		Input: make(chan int),
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 2,
+		},
+		Sliding: true,
+	}
+
+	_, err = New(opts)
+	require.ErrorIs(t, err, ErrBurstWithSliding)
+
 	opts = Opts[int]{
 		Input: make(chan int),
 		Limit: Rate{
@@ -82,6 +109,653 @@ func testDiscipline(t *testing.T, quantity int, limit Rate) time.Duration {
 	return duration
 }
 
+func TestDisciplineClock(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int, 5)
+
+	for item := range cap(input) {
+		input <- item
+	}
+
+	close(input)
+
+	opts := Opts[int]{
+		Clock: manual,
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 2,
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, 0, <-discipline.Output())
+	require.Equal(t, 1, <-discipline.Output())
+
+	// Waiting for the delay to start
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, 2, <-discipline.Output())
+	require.Equal(t, 3, <-discipline.Output())
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, 4, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineBucket(t *testing.T) {
+	quantity := 2000
+
+	limit, err := Rate{Interval: time.Second, Quantity: 1000}.Optimize()
+	require.NoError(t, err)
+
+	input := make(chan int, quantity)
+
+	opts := Opts[int]{
+		Burst: 1000,
+		Input: input,
+		Limit: limit,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	startedAt := time.Now()
+
+	go func() {
+		defer close(input)
+
+		for item := range quantity {
+			input <- item
+		}
+	}()
+
+	expected := 0
+
+	for item := range discipline.Output() {
+		require.Equal(t, expected, item)
+
+		expected++
+	}
+
+	// Data elements in the quantity of the Burst are passed without delay
+	require.InEpsilon(t, time.Second, time.Since(startedAt), 0.1)
+}
+
+func TestDisciplineBucketClock(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int, 10)
+
+	opts := Opts[int]{
+		Burst: 4,
+		Clock: manual,
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 2,
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	for item := range 6 {
+		input <- item
+	}
+
+	// Bucket is initially full
+	for item := range 4 {
+		require.Equal(t, item, <-discipline.Output())
+	}
+
+	// Waiting for the delay to start
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, 4, <-discipline.Output())
+	require.Equal(t, 5, <-discipline.Output())
+
+	// Tokens are accumulated while there are no data elements, but not more than
+	// the Burst
+	manual.Advance(10 * time.Second)
+
+	for item := 6; item < 12; item++ {
+		input <- item
+	}
+
+	for item := 6; item < 10; item++ {
+		require.Equal(t, item, <-discipline.Output())
+	}
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, 10, <-discipline.Output())
+	require.Equal(t, 11, <-discipline.Output())
+
+	close(input)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineCost(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan uint64, 6)
+
+	// Data elements are their own costs
+	for _, item := range []uint64{4, 5, 3, 25, 2, 1} {
+		input <- item
+	}
+
+	close(input)
+
+	opts := Opts[uint64]{
+		Clock: manual,
+		Cost:  func(item uint64) uint64 { return item },
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 10,
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	// Last data element exceeds the rest of the budget, but is passed
+	require.Equal(t, uint64(4), <-discipline.Output())
+	require.Equal(t, uint64(5), <-discipline.Output())
+	require.Equal(t, uint64(3), <-discipline.Output())
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	// Data element exceeds the whole budget, but is passed alone
+	require.Equal(t, uint64(25), <-discipline.Output())
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	// Time interval is spent to pay off the debt
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, uint64(2), <-discipline.Output())
+	require.Equal(t, uint64(1), <-discipline.Output())
+
+	// Budget of the time interval is spent after the debt is paid off
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineCostBucket(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan uint64, 4)
+
+	// Data elements are their own costs
+	for _, item := range []uint64{3, 1, 6, 2} {
+		input <- item
+	}
+
+	close(input)
+
+	opts := Opts[uint64]{
+		Burst: 4,
+		Clock: manual,
+		Cost:  func(item uint64) uint64 { return item },
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 2,
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(3), <-discipline.Output())
+	require.Equal(t, uint64(1), <-discipline.Output())
+
+	// Data element exceeds the Burst, so it waits for the bucket to fill
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, uint64(6), <-discipline.Output())
+
+	// Debt is paid off before the bucket is refilled
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, uint64(2), <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineCostZero(t *testing.T) {
+	testDisciplineCostZero(t, 0, false, 3)
+	testDisciplineCostZero(t, 10, false, 2)
+	testDisciplineCostZero(t, 0, true, 3)
+}
+
+func testDisciplineCostZero(t *testing.T, burst uint64, sliding bool, intervals int) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan uint64, 3)
+
+	// Data elements are their own costs
+	for _, item := range []uint64{30, 0, 0} {
+		input <- item
+	}
+
+	close(input)
+
+	opts := Opts[uint64]{
+		Burst: burst,
+		Clock: manual,
+		Cost:  func(item uint64) uint64 { return item },
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 10,
+		},
+		Sliding: sliding,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(30), <-discipline.Output())
+
+	// Data elements with zero cost wait while the debt is being paid off
+	for range intervals {
+		manual.BlockUntil(1)
+		require.Empty(t, discipline.Output(), "burst: %v, sliding: %v", burst, sliding)
+
+		manual.Advance(time.Second)
+	}
+
+	// Data elements with zero cost do not consume the budget
+	require.Equal(t, uint64(0), <-discipline.Output())
+	require.Equal(t, uint64(0), <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineSliding(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int)
+
+	opts := Opts[int]{
+		Clock: manual,
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 2,
+		},
+		Sliding: true,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 0
+	require.Equal(t, 0, <-discipline.Output())
+
+	manual.Advance(900 * time.Millisecond)
+
+	input <- 1
+	require.Equal(t, 1, <-discipline.Output())
+
+	manual.Advance(100 * time.Millisecond)
+
+	// First data element has left the window
+	input <- 2
+	require.Equal(t, 2, <-discipline.Output())
+
+	input <- 3
+
+	// Unlike the default mode, the data element is delayed until the second one
+	// leaves the window
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(899 * time.Millisecond)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Millisecond)
+	require.Equal(t, 3, <-discipline.Output())
+
+	close(input)
+
+	// Discipline is terminated without delay
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineSlidingCost(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan uint64, 5)
+
+	// Data elements are their own costs
+	for _, item := range []uint64{4, 5, 3, 25, 2} {
+		input <- item
+	}
+
+	close(input)
+
+	opts := Opts[uint64]{
+		Clock: manual,
+		Cost:  func(item uint64) uint64 { return item },
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 10,
+		},
+		Sliding: true,
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(4), <-discipline.Output())
+	require.Equal(t, uint64(5), <-discipline.Output())
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, uint64(3), <-discipline.Output())
+
+	// Data element exceeds the whole budget, so it waits for the window to become
+	// empty
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, uint64(25), <-discipline.Output())
+
+	// Data element occupies the window for three time intervals
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(2 * time.Second)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, uint64(2), <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineSetRate(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int, 7)
+
+	for item := range cap(input) {
+		input <- item
+	}
+
+	close(input)
+
+	opts := Opts[int]{
+		Clock: manual,
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 2,
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, discipline.SetRate(Rate{Interval: time.Second}), ErrQuantityZero)
+
+	require.Equal(t, 0, <-discipline.Output())
+	require.Equal(t, 1, <-discipline.Output())
+
+	manual.BlockUntil(1)
+
+	// New rate limit is applied at the beginning of the next time interval
+	require.NoError(t, discipline.SetRate(Rate{Interval: time.Second, Quantity: 3}))
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, 2, <-discipline.Output())
+	require.Equal(t, 3, <-discipline.Output())
+	require.Equal(t, 4, <-discipline.Output())
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, 5, <-discipline.Output())
+	require.Equal(t, 6, <-discipline.Output())
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineSetRateIdle(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int, 3)
+
+	opts := Opts[int]{
+		Clock: manual,
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 1000,
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	input <- 0
+	require.Equal(t, 0, <-discipline.Output())
+
+	// Discipline waits for data elements longer than the time interval
+	manual.Advance(10 * time.Second)
+
+	require.NoError(t, discipline.SetRate(Rate{Interval: time.Second, Quantity: 1}))
+
+	input <- 1
+	input <- 2
+	input <- 3
+
+	// Rest of the budget of the ended time interval is not used
+	require.Equal(t, 1, <-discipline.Output())
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, 2, <-discipline.Output())
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	require.Equal(t, 3, <-discipline.Output())
+
+	close(input)
+
+	manual.BlockUntil(1)
+	manual.Advance(time.Second)
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineSetRateBucket(t *testing.T) {
+	manual := clock.NewManual(time.Time{})
+
+	input := make(chan int, 10)
+
+	for item := range cap(input) {
+		input <- item
+	}
+
+	close(input)
+
+	opts := Opts[int]{
+		Burst: 4,
+		Clock: manual,
+		Input: input,
+		Limit: Rate{
+			Interval: time.Second,
+			Quantity: 2,
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	require.ErrorIs(
+		t,
+		discipline.SetRate(Rate{Interval: time.Second, Quantity: 5}),
+		ErrBurstTooSmall,
+	)
+
+	for item := range 4 {
+		require.Equal(t, item, <-discipline.Output())
+	}
+
+	manual.BlockUntil(1)
+
+	require.NoError(t, discipline.SetRate(Rate{Interval: time.Second, Quantity: 4}))
+
+	// Tokens accumulated before the refilling are calculated according to
+	// the previous rate limit
+	manual.Advance(time.Second)
+
+	require.Equal(t, 4, <-discipline.Output())
+	require.Equal(t, 5, <-discipline.Output())
+
+	manual.BlockUntil(1)
+	require.Empty(t, discipline.Output())
+
+	manual.Advance(time.Second)
+
+	for item := 6; item < 10; item++ {
+		require.Equal(t, item, <-discipline.Output())
+	}
+
+	_, opened := <-discipline.Output()
+	require.False(t, opened)
+}
+
+func TestDisciplineSetRateConcurrently(t *testing.T) {
+	quantity := 1000
+
+	input := make(chan int, quantity)
+
+	opts := Opts[int]{
+		Input: input,
+		Limit: Rate{
+			Interval: time.Millisecond,
+			Quantity: 10,
+		},
+	}
+
+	discipline, err := New(opts)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(input)
+
+		for item := range quantity {
+			input <- item
+		}
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for quantity := uint64(1); ; quantity = quantity%100 + 1 {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			err := discipline.SetRate(Rate{Interval: time.Millisecond, Quantity: quantity})
+			require.NoError(t, err)
+		}
+	}()
+
+	expected := 0
+
+	for item := range discipline.Output() {
+		require.Equal(t, expected, item)
+
+		expected++
+	}
+
+	require.Equal(t, quantity, expected)
+}
+
 func calcExpectedDuration(quantity int, limit Rate) time.Duration {
 	// Accuracy of calculations is deliberately roughened (first division is performed
 	// and only then multiplication) because such a calculation corresponds to the work
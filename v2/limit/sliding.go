@@ -0,0 +1,95 @@
+package limit
+
+import (
+	"math"
+	"time"
+)
+
+// Record of the sliding window log about the passed data element.
+type passage struct {
+	cost      uint64
+	expiresAt time.Time
+}
+
+// In the sliding window mode, the time of passing and the cost of each data element
+// passed to the output channel are recorded in the log, and a data element is
+// passed only when the total cost of the data elements passed within the last time
+// Interval, together with its own cost, does not exceed the Quantity field of
+// the rate limit structure.
+func (dsc *Discipline[Type]) loopSliding() {
+	for item := range dsc.opts.Input {
+		cost := dsc.cost(item)
+
+		dsc.acquireWindow(cost)
+		dsc.record(cost)
+		dsc.send(item)
+	}
+}
+
+// Waits for the data elements passed earlier to leave the window so much that
+// the specified cost fits into it. If the cost exceeds the Quantity field of
+// the rate limit structure, it waits for the window to become empty.
+func (dsc *Discipline[Type]) acquireWindow(cost uint64) {
+	dsc.applyRate()
+	dsc.expire()
+
+	for len(dsc.log) != 0 && addSaturated(dsc.spent, cost) > dsc.opts.Limit.Quantity {
+		dsc.opts.Clock.Sleep(dsc.log[0].expiresAt.Sub(dsc.opts.Clock.Now()))
+		dsc.applyRate()
+		dsc.expire()
+	}
+}
+
+// Removes from the log the records of the data elements that have left the window.
+func (dsc *Discipline[Type]) expire() {
+	now := dsc.opts.Clock.Now()
+
+	for len(dsc.log) != 0 && !dsc.log[0].expiresAt.After(now) {
+		dsc.spent -= dsc.log[0].cost
+		dsc.log = dsc.log[1:]
+	}
+}
+
+// Adds to the log the record of the passed data element. Data elements with zero
+// cost are not recorded because they do not occupy the window.
+func (dsc *Discipline[Type]) record(cost uint64) {
+	if cost == 0 {
+		return
+	}
+
+	record := passage{
+		cost:      cost,
+		expiresAt: dsc.opts.Clock.Now().Add(dsc.occupation(cost)),
+	}
+
+	dsc.log = append(dsc.log, record)
+
+	// Integer overflow is impossible because the data element is passed either when
+	// the sum does not exceed the Quantity field of the rate limit structure or when
+	// the window is empty
+	dsc.spent += cost
+}
+
+// Returns the duration during which the data element with the specified cost
+// occupies the window. A data element whose cost exceeds the Quantity field of
+// the rate limit structure occupies the window for as many time intervals as
+// are required to pay off its cost.
+func (dsc *Discipline[Type]) occupation(cost uint64) time.Duration {
+	if cost <= dsc.opts.Limit.Quantity {
+		return dsc.opts.Limit.Interval
+	}
+
+	intervals := cost / dsc.opts.Limit.Quantity
+
+	if cost%dsc.opts.Limit.Quantity != 0 {
+		intervals++
+	}
+
+	// Conversion is safe because the Interval field of the rate limit structure is
+	// positive
+	if intervals > uint64(math.MaxInt64/dsc.opts.Limit.Interval) {
+		return math.MaxInt64
+	}
+
+	return time.Duration(intervals) * dsc.opts.Limit.Interval
+}
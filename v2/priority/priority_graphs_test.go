@@ -7,12 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/akramarenkov/cqos/v2/analysis"
+	"github.com/akramarenkov/cqos/v2/cqostest"
 	"github.com/akramarenkov/cqos/v2/internal/env"
 	"github.com/akramarenkov/cqos/v2/priority/divider"
 	"github.com/akramarenkov/cqos/v2/priority/internal/common"
-	"github.com/akramarenkov/cqos/v2/priority/internal/measurer"
-	"github.com/akramarenkov/cqos/v2/priority/internal/research"
-	"github.com/akramarenkov/cqos/v2/priority/internal/unmanaged"
+	"github.com/akramarenkov/cqos/v2/recorder"
 
 	"github.com/go-echarts/go-echarts/v2/charts"
 	chartsopts "github.com/go-echarts/go-echarts/v2/opts"
@@ -121,25 +121,25 @@ func createGraphs(
 	filePrefix string,
 	handlersQuantity uint,
 	unbufferedInput bool,
-	measures []measurer.Measure,
+	measures []recorder.Event,
 	overTimeResolution time.Duration,
 	overTimeUnit time.Duration,
 	writeToFeedbackInterval time.Duration,
 ) {
-	received := research.FilterByKind(measures, measurer.MeasureKindReceived)
+	received := analysis.FilterByKind(measures, recorder.KindReceived)
 
-	dqot, dqotX := research.ConvertToLineEcharts(
-		research.CalcDataQuantity(received, overTimeResolution),
+	dqot, dqotX := analysis.ConvertToLineEcharts(
+		analysis.CalcDataQuantity(received, overTimeResolution),
 		overTimeUnit,
 	)
 
-	ipot, ipotX := research.ConvertToLineEcharts(
-		research.CalcInProcessing(measures, overTimeResolution),
+	ipot, ipotX := analysis.ConvertToLineEcharts(
+		analysis.CalcInProcessing(measures, overTimeResolution),
 		overTimeUnit,
 	)
 
-	wtfl, wtflX := research.ConvertToBarEcharts(
-		research.CalcWriteToFeedbackLatency(measures, writeToFeedbackInterval),
+	wtfl, wtflX := analysis.ConvertToBarEcharts(
+		analysis.CalcWriteToFeedbackLatency(measures, writeToFeedbackInterval),
 	)
 
 	subtitle := fmt.Sprintf(
@@ -191,12 +191,12 @@ func testGraphFairEven(t *testing.T, factor uint, unbufferedInput bool) {
 		t.SkipNow()
 	}
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6 * factor,
 		UnbufferedInput:  unbufferedInput,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 4000*factor)
 
@@ -256,12 +256,12 @@ func testGraphFairUneven(t *testing.T, factor uint, unbufferedInput bool) {
 		t.SkipNow()
 	}
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6 * factor,
 		UnbufferedInput:  unbufferedInput,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 450*factor)
 
@@ -321,12 +321,12 @@ func testGraphRateEven(t *testing.T, factor uint, unbufferedInput bool) {
 		t.SkipNow()
 	}
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6 * factor,
 		UnbufferedInput:  unbufferedInput,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 4100*factor)
 
@@ -386,12 +386,12 @@ func testGraphRateUneven(t *testing.T, factor uint, unbufferedInput bool) {
 		t.SkipNow()
 	}
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6 * factor,
 		UnbufferedInput:  unbufferedInput,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 430*factor)
 
@@ -451,12 +451,12 @@ func testGraphUnmanagedEven(t *testing.T, factor uint, unbufferedInput bool) {
 		t.SkipNow()
 	}
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6 * factor,
 		UnbufferedInput:  unbufferedInput,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 4000*factor)
 
@@ -480,11 +480,11 @@ func testGraphUnmanagedEven(t *testing.T, factor uint, unbufferedInput bool) {
 	msr.SetProcessDelay(2, 10*time.Millisecond)
 	msr.SetProcessDelay(3, 10*time.Millisecond)
 
-	unmanagedOpts := unmanaged.Opts[uint]{
+	unmanagedOpts := cqostest.UnmanagedOpts[uint]{
 		Inputs: msr.GetInputs(),
 	}
 
-	unmanaged, err := unmanaged.New(unmanagedOpts)
+	unmanaged, err := cqostest.NewUnmanaged(unmanagedOpts)
 	require.NoError(t, err)
 
 	measures := msr.Play(unmanaged)
@@ -514,12 +514,12 @@ func testGraphUnmanagedUneven(t *testing.T, factor uint, unbufferedInput bool) {
 		t.SkipNow()
 	}
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6 * factor,
 		UnbufferedInput:  unbufferedInput,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 500*factor)
 
@@ -543,11 +543,11 @@ func testGraphUnmanagedUneven(t *testing.T, factor uint, unbufferedInput bool) {
 	msr.SetProcessDelay(2, 50*time.Millisecond)
 	msr.SetProcessDelay(3, 10*time.Millisecond)
 
-	unmanagedOpts := unmanaged.Opts[uint]{
+	unmanagedOpts := cqostest.UnmanagedOpts[uint]{
 		Inputs: msr.GetInputs(),
 	}
 
-	unmanaged, err := unmanaged.New(unmanagedOpts)
+	unmanaged, err := cqostest.NewUnmanaged(unmanagedOpts)
 	require.NoError(t, err)
 
 	measures := msr.Play(unmanaged)
@@ -577,11 +577,11 @@ func testGraphFairEvenDividingError(t *testing.T, handlersQuantity uint) {
 		t.SkipNow()
 	}
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: handlersQuantity,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 4000)
 
@@ -6,12 +6,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/akramarenkov/cqos/v2/analysis"
+	"github.com/akramarenkov/cqos/v2/cqostest"
 	"github.com/akramarenkov/cqos/v2/internal/env"
 	"github.com/akramarenkov/cqos/v2/priority/divider"
 	"github.com/akramarenkov/cqos/v2/priority/internal/common"
-	"github.com/akramarenkov/cqos/v2/priority/internal/measurer"
-	"github.com/akramarenkov/cqos/v2/priority/internal/research"
-	"github.com/akramarenkov/cqos/v2/priority/internal/unmanaged"
+	"github.com/akramarenkov/cqos/v2/recorder"
 
 	"github.com/stretchr/testify/require"
 	"github.com/wcharczuk/go-chart/v2"
@@ -41,11 +41,11 @@ func testReadmeGraph(t *testing.T, equaling bool) {
 		t.SkipNow()
 	}
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 500)
 	msr.AddWrite(2, 500)
@@ -81,11 +81,11 @@ func testReadmeGraph(t *testing.T, equaling bool) {
 		return
 	}
 
-	unmanagedOpts := unmanaged.Opts[uint]{
+	unmanagedOpts := cqostest.UnmanagedOpts[uint]{
 		Inputs: msr.GetInputs(),
 	}
 
-	unmanaged, err := unmanaged.New(unmanagedOpts)
+	unmanaged, err := cqostest.NewUnmanaged(unmanagedOpts)
 	require.NoError(t, err)
 
 	createReadmeGraph(
@@ -101,13 +101,13 @@ func testReadmeGraph(t *testing.T, equaling bool) {
 func createReadmeGraph(
 	t *testing.T,
 	fileName string,
-	measures []measurer.Measure,
+	measures []recorder.Event,
 	overTimeResolution time.Duration,
 	overTimeUnit time.Duration,
 	overTimeUnitName string,
 ) {
-	received := research.FilterByKind(measures, measurer.MeasureKindReceived)
-	researched := research.CalcDataQuantity(received, overTimeResolution)
+	received := analysis.FilterByKind(measures, recorder.KindReceived)
+	researched := analysis.CalcDataQuantity(received, overTimeResolution)
 
 	serieses := make([]chart.Series, 0, len(researched))
 	priorities := make([]uint, 0, len(researched))
@@ -4,9 +4,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/akramarenkov/cqos/v2/analysis"
+	"github.com/akramarenkov/cqos/v2/cqostest"
 	"github.com/akramarenkov/cqos/v2/priority/divider"
-	"github.com/akramarenkov/cqos/v2/priority/internal/measurer"
-	"github.com/akramarenkov/cqos/v2/priority/internal/research"
 
 	"github.com/stretchr/testify/require"
 )
@@ -45,12 +45,12 @@ func TestOptsValidation(t *testing.T) {
 }
 
 func BenchmarkDisciplineFair(b *testing.B) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		DisableMeasures:  true,
 		HandlersQuantity: 600,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 5000000)
 	msr.AddWrite(2, 5000000)
@@ -69,12 +69,12 @@ func BenchmarkDisciplineFair(b *testing.B) {
 }
 
 func BenchmarkDisciplineRate(b *testing.B) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		DisableMeasures:  true,
 		HandlersQuantity: 600,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 5000000)
 	msr.AddWrite(2, 5000000)
@@ -93,13 +93,13 @@ func BenchmarkDisciplineRate(b *testing.B) {
 }
 
 func BenchmarkDisciplineFairUnbuffered(b *testing.B) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		DisableMeasures:  true,
 		HandlersQuantity: 600,
 		UnbufferedInput:  true,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 5000000)
 	msr.AddWrite(2, 5000000)
@@ -118,13 +118,13 @@ func BenchmarkDisciplineFairUnbuffered(b *testing.B) {
 }
 
 func BenchmarkDisciplineRateUnbuffered(b *testing.B) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		DisableMeasures:  true,
 		HandlersQuantity: 600,
 		UnbufferedInput:  true,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 5000000)
 	msr.AddWrite(2, 5000000)
@@ -143,11 +143,11 @@ func BenchmarkDisciplineRateUnbuffered(b *testing.B) {
 }
 
 func TestDisciplineFair(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 100000)
 	msr.AddWrite(2, 100000)
@@ -168,11 +168,11 @@ func TestDisciplineFair(t *testing.T) {
 }
 
 func TestDisciplineRate(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 100000)
 	msr.AddWrite(2, 100000)
@@ -193,12 +193,12 @@ func TestDisciplineRate(t *testing.T) {
 }
 
 func TestDisciplineFairUnbuffered(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6,
 		UnbufferedInput:  true,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 100000)
 	msr.AddWrite(2, 100000)
@@ -219,12 +219,12 @@ func TestDisciplineFairUnbuffered(t *testing.T) {
 }
 
 func TestDisciplineRateUnbuffered(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6,
 		UnbufferedInput:  true,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 100000)
 	msr.AddWrite(2, 100000)
@@ -245,11 +245,11 @@ func TestDisciplineRateUnbuffered(t *testing.T) {
 }
 
 func TestDisciplineBadDivider(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 100000)
 	msr.AddWrite(2, 100000)
@@ -286,11 +286,11 @@ func TestDisciplineBadDivider(t *testing.T) {
 }
 
 func TestDisciplineBadDividerInRecalc(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 0)
 	msr.AddWrite(2, 0)
@@ -327,11 +327,11 @@ func TestDisciplineBadDividerInRecalc(t *testing.T) {
 }
 
 func TestDisciplineBadDividerInNew(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 6,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 1)
 	msr.AddWrite(2, 1)
@@ -358,11 +358,11 @@ func TestDisciplineBadDividerInNew(t *testing.T) {
 func TestDisciplineFairOverQuantity(t *testing.T) {
 	handlersQuantity := uint(6)
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 2 * handlersQuantity,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 1000000)
 	msr.AddWrite(2, 100000)
@@ -379,7 +379,7 @@ func TestDisciplineFairOverQuantity(t *testing.T) {
 
 	measures := msr.Play(discipline)
 
-	quantities := research.CalcInProcessing(measures, 100*time.Millisecond)
+	quantities := analysis.CalcInProcessing(measures, 100*time.Millisecond)
 
 	for priority := range quantities {
 		for id := range quantities[priority] {
@@ -391,11 +391,11 @@ func TestDisciplineFairOverQuantity(t *testing.T) {
 func TestDisciplineRateOverQuantity(t *testing.T) {
 	handlersQuantity := uint(6)
 
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 2 * handlersQuantity,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 100000)
 	msr.AddWrite(2, 100000)
@@ -412,7 +412,7 @@ func TestDisciplineRateOverQuantity(t *testing.T) {
 
 	measures := msr.Play(discipline)
 
-	quantities := research.CalcInProcessing(measures, 100*time.Millisecond)
+	quantities := analysis.CalcInProcessing(measures, 100*time.Millisecond)
 
 	for priority := range quantities {
 		for id := range quantities[priority] {
@@ -422,11 +422,11 @@ func TestDisciplineRateOverQuantity(t *testing.T) {
 }
 
 func TestDisciplineFairTooSmallHandlersQuantity(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 2,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 100000)
 	msr.AddWrite(2, 100000)
@@ -443,11 +443,11 @@ func TestDisciplineFairTooSmallHandlersQuantity(t *testing.T) {
 }
 
 func TestDisciplineRateTooSmallHandlersQuantity(t *testing.T) {
-	measurerOpts := measurer.Opts{
+	measurerOpts := cqostest.MeasurerOpts{
 		HandlersQuantity: 5,
 	}
 
-	msr := measurer.New(measurerOpts)
+	msr := cqostest.NewMeasurer(measurerOpts)
 
 	msr.AddWrite(1, 100000)
 	msr.AddWrite(2, 100000)
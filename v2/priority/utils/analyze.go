@@ -0,0 +1,306 @@
+package utils
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/akramarenkov/cqos/v2/internal/consts"
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+	"github.com/akramarenkov/cqos/v2/priority/internal/common"
+)
+
+// Distribution of handlers for one priority in a combination of priorities.
+type Share struct {
+	// Absolute difference between the actual and the ideal quantity of handlers
+	AbsoluteError float64
+	// Quantity of handlers given to the priority by the dividing function
+	Actual uint
+	// Ideal fractional quantity of handlers. It is calculated by dividing
+	// a large quantity of handlers by the same dividing function and scaling
+	// the result down to the analyzed quantity of handlers
+	Ideal float64
+	// Priority value
+	Priority uint
+	// Absolute error relative to the ideal quantity of handlers, in percents. If
+	// the ideal quantity of handlers is zero, then it is equal to +Inf. This value
+	// is compared with the limit in the IsSuitableConfig function
+	RelativeError float64
+}
+
+// Distribution of handlers for one combination of priorities.
+type Combination struct {
+	// True if at least one priority in the combination has no handlers
+	Fatal bool
+	// Maximum relative error among the priorities in the combination, in percents
+	MaxRelativeError float64
+	// Priorities of the combination sorted from highest to lowest
+	Priorities []uint
+	// Distribution of handlers in the same order as Priorities
+	Shares []Share
+}
+
+// Report on the quality of distribution of handlers by priorities for all
+// combinations of priorities or for the combinations checked by the Checker.
+type Report struct {
+	// Combinations of priorities sorted by the quantity of priorities, and then
+	// by the values of priorities from highest to lowest
+	Combinations []Combination
+	// Quantity of handlers
+	Quantity uint
+}
+
+// Analyzes the quality of distribution of the specified quantity of handlers by
+// the dividing function for each combination of priorities.
+//
+// Unlike the IsNonFatalConfig and IsSuitableConfig functions, which answer only yes
+// or no, it returns the ideal and actual distribution and its errors, which can be
+// used when choosing the quantity of handlers and the dividing function.
+func Analyze(priorities []uint, divider divider.Divider, quantity uint) Report {
+	priorities = createSortedCopy(priorities)
+
+	combinations := genCombinations(priorities)
+
+	return analyzeCombinations(priorities, combinations, divider, quantity)
+}
+
+// Priorities must be sorted, combinations are sorted in place.
+func analyzeCombinations(
+	priorities []uint,
+	combinations [][]uint,
+	divider divider.Divider,
+	quantity uint,
+) Report {
+	slices.SortStableFunc(combinations, compareCombinations)
+
+	referenceTotalQuantity := referenceFactor * common.SumPriorities(priorities)
+
+	report := Report{
+		Combinations: make([]Combination, 0, len(combinations)),
+		Quantity:     quantity,
+	}
+
+	for _, combination := range combinations {
+		analyzed := analyzeCombination(
+			combination,
+			divider,
+			quantity,
+			referenceTotalQuantity,
+		)
+
+		report.Combinations = append(report.Combinations, analyzed)
+	}
+
+	return report
+}
+
+func compareCombinations(first []uint, second []uint) int {
+	if len(first) != len(second) {
+		return cmp.Compare(len(first), len(second))
+	}
+
+	// Combinations are sorted from highest to lowest priority, so the comparison
+	// is reversed
+	return slices.Compare(second, first)
+}
+
+func analyzeCombination(
+	combination []uint,
+	divider divider.Divider,
+	quantity uint,
+	referenceTotalQuantity uint,
+) Combination {
+	distribution := make(map[uint]uint)
+	reference := make(map[uint]uint)
+
+	divider(combination, quantity, distribution)
+	divider(combination, referenceTotalQuantity, reference)
+
+	scale := float64(quantity) / float64(referenceTotalQuantity)
+
+	analyzed := Combination{
+		Fatal:      !common.IsDistributionFilled(distribution),
+		Priorities: combination,
+		Shares:     make([]Share, 0, len(combination)),
+	}
+
+	for _, priority := range combination {
+		share := Share{
+			Actual:   distribution[priority],
+			Ideal:    scale * float64(reference[priority]),
+			Priority: priority,
+		}
+
+		share.AbsoluteError = math.Abs(float64(share.Actual) - share.Ideal)
+		share.RelativeError = calcRelativeError(
+			share.Actual,
+			reference[priority],
+			quantity,
+			referenceTotalQuantity,
+		)
+
+		if share.Actual == 0 {
+			analyzed.Fatal = true
+		}
+
+		analyzed.MaxRelativeError = max(analyzed.MaxRelativeError, share.RelativeError)
+
+		analyzed.Shares = append(analyzed.Shares, share)
+	}
+
+	return analyzed
+}
+
+// Calculated in the same way as in the isDistributionSuitable function so that
+// the results of the analysis and the check are the same.
+func calcRelativeError(
+	actual uint,
+	reference uint,
+	quantity uint,
+	referenceTotalQuantity uint,
+) float64 {
+	// A bug is assumed in the dividing function, in which it always returns 0,
+	// even with large quantities, or the quantity of handlers is zero
+	if reference == 0 || quantity == 0 {
+		return math.Inf(1)
+	}
+
+	ratio := float64(referenceTotalQuantity) / float64(quantity)
+
+	return consts.HundredPercent * math.Abs(1.0-(ratio*float64(actual))/float64(reference))
+}
+
+// Returns the maximum relative error among all combinations, in percents.
+func (rpt Report) MaxRelativeError() float64 {
+	maximum := 0.0
+
+	for _, combination := range rpt.Combinations {
+		maximum = max(maximum, combination.MaxRelativeError)
+	}
+
+	return maximum
+}
+
+// Returns true if the distribution error does not cause stop processing of one or
+// more priorities in any combination. Corresponds to the IsNonFatalConfig function.
+func (rpt Report) IsNonFatal() bool {
+	for _, combination := range rpt.Combinations {
+		if combination.Fatal {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns true if the distribution error does not exceed the limit, specified as
+// a percentage, in any combination. Corresponds to the IsSuitableConfig function.
+func (rpt Report) IsSuitable(limit float64) bool {
+	for _, combination := range rpt.Combinations {
+		if combination.Fatal {
+			return false
+		}
+
+		if combination.MaxRelativeError > limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns up to the specified quantity of combinations with the largest errors.
+// Combinations with a fatal error come first, then combinations are sorted by
+// the maximum relative error from largest to smallest.
+func (rpt Report) Worst(quantity int) []Combination {
+	sorted := slices.Clone(rpt.Combinations)
+
+	slices.SortStableFunc(sorted, compareCombinationsErrors)
+
+	return sorted[:min(max(quantity, 0), len(sorted))]
+}
+
+func compareCombinationsErrors(first Combination, second Combination) int {
+	if first.Fatal != second.Fatal {
+		if first.Fatal {
+			return -1
+		}
+
+		return 1
+	}
+
+	return cmp.Compare(second.MaxRelativeError, first.MaxRelativeError)
+}
+
+// Writes the report to the writer as a text table with one line per priority in
+// each combination.
+func (rpt Report) WriteTable(writer io.Writer) error {
+	return writeTable(writer, rpt.Combinations)
+}
+
+// Returns the report as a text table. Equivalent to the WriteTable method.
+func (rpt Report) String() string {
+	builder := &strings.Builder{}
+
+	// Writing to strings.Builder never returns an error
+	_ = rpt.WriteTable(builder)
+
+	return builder.String()
+}
+
+func writeTable(writer io.Writer, combinations []Combination) error {
+	const (
+		minWidth = 0
+		tabWidth = 0
+		padding  = 2
+		padChar  = ' '
+		flags    = 0
+	)
+
+	const (
+		header = "combination\tpriority\tideal\tactual\tabsolute error\trelative error, %\n"
+	)
+
+	table := tabwriter.NewWriter(writer, minWidth, tabWidth, padding, padChar, flags)
+
+	if _, err := fmt.Fprint(table, header); err != nil {
+		return err
+	}
+
+	for _, combination := range combinations {
+		name := formatCombination(combination.Priorities)
+
+		for _, share := range combination.Shares {
+			_, err := fmt.Fprintf(
+				table,
+				"%s\t%d\t%.3f\t%d\t%.3f\t%.2f\n",
+				name,
+				share.Priority,
+				share.Ideal,
+				share.Actual,
+				share.AbsoluteError,
+				share.RelativeError,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return table.Flush()
+}
+
+func formatCombination(priorities []uint) string {
+	formatted := make([]string, 0, len(priorities))
+
+	for _, priority := range priorities {
+		formatted = append(formatted, strconv.Itoa(int(priority)))
+	}
+
+	return "[" + strings.Join(formatted, " ") + "]"
+}
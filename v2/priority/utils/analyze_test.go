@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errWriterFailed = errors.New("writer failed")
+)
+
+type failedWriter struct{}
+
+func (failedWriter) Write([]byte) (int, error) {
+	return 0, errWriterFailed
+}
+
+func TestAnalyze(t *testing.T) {
+	report := Analyze([]uint{1, 3, 2}, divider.Rate, 6)
+
+	require.Equal(t, uint(6), report.Quantity)
+	require.Len(t, report.Combinations, 7)
+
+	expectedPriorities := [][]uint{
+		{3},
+		{2},
+		{1},
+		{3, 2},
+		{3, 1},
+		{2, 1},
+		{3, 2, 1},
+	}
+
+	for id, combination := range report.Combinations {
+		require.Equal(t, expectedPriorities[id], combination.Priorities)
+		require.Len(t, combination.Shares, len(combination.Priorities))
+	}
+
+	full := report.Combinations[len(report.Combinations)-1]
+
+	require.False(t, full.Fatal)
+	require.InDelta(t, 0.0, full.MaxRelativeError, 1e-9)
+
+	for id, share := range full.Shares {
+		require.Equal(t, full.Priorities[id], share.Priority)
+		require.Equal(t, share.Priority, share.Actual)
+		require.InDelta(t, float64(share.Priority), share.Ideal, 1e-9)
+		require.InDelta(t, 0.0, share.AbsoluteError, 1e-9)
+		require.InDelta(t, 0.0, share.RelativeError, 1e-9)
+	}
+
+	// 6 / [3 1] = map[3:5 1:2], ideal = map[3:4.5 1:1.5]
+	pair := report.Combinations[4]
+
+	require.Equal(t, []uint{3, 1}, pair.Priorities)
+	require.InDelta(t, 4.5, pair.Shares[0].Ideal, 1e-9)
+	require.InDelta(t, 1.5, pair.Shares[1].Ideal, 1e-9)
+	require.InDelta(t, 0.5, pair.Shares[0].AbsoluteError, 1e-9)
+	require.InDelta(t, 0.5, pair.Shares[1].AbsoluteError, 1e-9)
+	require.InDelta(t, 100.0/3, pair.MaxRelativeError, 1e-9)
+}
+
+func TestAnalyzeFatal(t *testing.T) {
+	report := Analyze([]uint{3, 2, 1}, divider.Fair, 2)
+
+	require.False(t, report.IsNonFatal())
+	require.False(t, report.IsSuitable(math.MaxFloat64))
+
+	worst := report.Worst(1)
+	require.Len(t, worst, 1)
+	require.True(t, worst[0].Fatal)
+	require.Equal(t, []uint{3, 2, 1}, worst[0].Priorities)
+
+	report = Analyze([]uint{3, 2, 1}, divider.Fair, 0)
+	require.False(t, report.IsNonFatal())
+	require.True(t, math.IsInf(report.MaxRelativeError(), 1))
+}
+
+func TestAnalyzeWorst(t *testing.T) {
+	report := Analyze([]uint{3, 2, 1}, divider.Rate, 6)
+
+	require.Empty(t, report.Worst(0))
+	require.Empty(t, report.Worst(-1))
+	require.Len(t, report.Worst(100), len(report.Combinations))
+
+	worst := report.Worst(len(report.Combinations))
+
+	for id := range worst[1:] {
+		require.GreaterOrEqual(t, worst[id].MaxRelativeError, worst[id+1].MaxRelativeError)
+	}
+
+	require.InDelta(t, report.MaxRelativeError(), worst[0].MaxRelativeError, 1e-9)
+}
+
+func TestAnalyzeConsistency(t *testing.T) {
+	priorities := []uint{70, 20, 10}
+	limits := []float64{0, 1, 5, 10, 20, 50}
+
+	for _, divider := range []divider.Divider{divider.Fair, divider.Rate} {
+		for quantity := uint(0); quantity <= 100; quantity++ {
+			report := Analyze(priorities, divider, quantity)
+
+			require.Equal(
+				t,
+				IsNonFatalConfig(priorities, divider, quantity),
+				report.IsNonFatal(),
+				"quantity: %v",
+				quantity,
+			)
+
+			for _, limit := range limits {
+				require.Equal(
+					t,
+					IsSuitableConfig(priorities, divider, quantity, limit),
+					report.IsSuitable(limit),
+					"quantity: %v, limit: %v",
+					quantity,
+					limit,
+				)
+			}
+		}
+	}
+}
+
+func TestReportWriteTable(t *testing.T) {
+	report := Analyze([]uint{2, 1}, divider.Rate, 3)
+
+	expected := `combination  priority  ideal  actual  absolute error  relative error, %
+[2]          2         3.000  3       0.000           0.00
+[1]          1         3.000  3       0.000           0.00
+[2 1]        2         2.000  2       0.000           0.00
+[2 1]        1         1.000  1       0.000           0.00
+`
+
+	buffer := &bytes.Buffer{}
+
+	err := report.WriteTable(buffer)
+	require.NoError(t, err)
+	require.Equal(t, expected, buffer.String())
+	require.Equal(t, expected, report.String())
+
+	require.Error(t, report.WriteTable(failedWriter{}))
+}
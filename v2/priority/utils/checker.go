@@ -0,0 +1,481 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+	"github.com/akramarenkov/cqos/v2/priority/internal/common"
+)
+
+var (
+	ErrConfidenceInvalid = errors.New("confidence is out of range (0, 1)")
+	ErrDividerEmpty      = errors.New("dividing function was not specified")
+	ErrDividerNotFair    = errors.New("fair method is applicable only to fair dividing function")
+	ErrMethodUnknown     = errors.New("method of selection of combinations is unknown")
+	ErrPrioritiesEmpty   = errors.New("priorities was not specified")
+	ErrPrioritiesTooMany = errors.New("too many priorities for exhaustive method")
+	ErrToleranceInvalid  = errors.New("tolerance is out of range (0, 1)")
+)
+
+const (
+	// Default value of Confidence option if it is not specified.
+	DefaultConfidence = 0.99
+	// Default value of Tolerance option if it is not specified.
+	DefaultTolerance = 0.001
+)
+
+const (
+	// Combinations are enumerated by bit masks of this width.
+	maxExhaustivePriorities = 63
+)
+
+// Method of selection of the combinations of priorities checked by the Checker.
+type Method int
+
+const (
+	// All 2^n - 1 combinations of priorities are checked. Combinations are
+	// generated on the fly, so memory consumption does not depend on their quantity,
+	// but check time grows as 2^n.
+	//
+	// Result is exact for any dividing function.
+	MethodExhaustive Method = iota + 1
+	// One combination is checked for each quantity of priorities (n combinations).
+	//
+	// Result is exact for dividing functions whose result depends only on the
+	// quantity of priorities and not on their values, such as divider.Fair. For
+	// such dividing functions all combinations with the same quantity of priorities
+	// are distributed identically. Moreover, the absence of a fatal error is
+	// monotonic over the quantity of handlers, so the quantity of handlers is
+	// picked up by binary search.
+	//
+	// Only divider.Fair is accepted, for other dividing functions the result would
+	// be wrong. There is no exact method reducing the quantity of combinations for
+	// divider.Rate, see MethodSampling.
+	MethodFair
+	// Random combinations are checked, their quantity is calculated from the
+	// Confidence and Tolerance options. In addition to random combinations, n
+	// combinations made up of the highest priorities are always checked. These
+	// combinations have the largest sum of priorities for each lowest priority, so
+	// the lowest priority gets the smallest share, which is the most likely cause
+	// of errors for divider.Rate.
+	//
+	// Result is approximate: if the check is passed, then with probability equal to
+	// Confidence the fraction of combinations that do not pass the check does not
+	// exceed Tolerance.
+	//
+	// Note that for divider.Rate there is no exact reduction of the combinations
+	// quantity, because due to the rounding and the exhausting of the remainder,
+	// the error of the combination is not determined by its sum or its lowest
+	// priority.
+	MethodSampling
+)
+
+// Options of the created checker.
+type CheckerOpts struct {
+	// Probability with which the result of the MethodSampling is correct within
+	// the Tolerance. A zero value means that the DefaultConfidence is used
+	Confidence float64
+	// Dividing function
+	Divider divider.Divider
+	// Method of selection of the checked combinations of priorities
+	Method Method
+	// Priorities, the order does not matter
+	Priorities []uint
+	// Seed of the random numbers generator used by the MethodSampling
+	Seed uint64
+	// Maximum fraction of combinations that do not pass the check and that may be
+	// missed by the MethodSampling. A zero value means that the DefaultTolerance
+	// is used
+	Tolerance float64
+}
+
+func (opts CheckerOpts) isValid() error {
+	if opts.Divider == nil {
+		return ErrDividerEmpty
+	}
+
+	if len(opts.Priorities) == 0 {
+		return ErrPrioritiesEmpty
+	}
+
+	switch opts.Method {
+	case MethodExhaustive:
+		if len(opts.Priorities) > maxExhaustivePriorities {
+			return ErrPrioritiesTooMany
+		}
+	case MethodFair:
+		if !isFairDivider(opts.Divider) {
+			return ErrDividerNotFair
+		}
+	case MethodSampling:
+		if opts.Confidence <= 0 || opts.Confidence >= 1 {
+			return ErrConfidenceInvalid
+		}
+
+		if opts.Tolerance <= 0 || opts.Tolerance >= 1 {
+			return ErrToleranceInvalid
+		}
+	default:
+		return ErrMethodUnknown
+	}
+
+	return nil
+}
+
+// Functions cannot be compared, so their entry addresses are compared.
+func isFairDivider(dividing divider.Divider) bool {
+	return reflect.ValueOf(dividing).Pointer() == reflect.ValueOf(divider.Fair).Pointer()
+}
+
+func (opts CheckerOpts) normalize() CheckerOpts {
+	if opts.Confidence == 0 {
+		opts.Confidence = DefaultConfidence
+	}
+
+	if opts.Tolerance == 0 {
+		opts.Tolerance = DefaultTolerance
+	}
+
+	return opts
+}
+
+// Checks the configurations of priorities, dividing function and quantity of
+// handlers like IsNonFatalConfig and IsSuitableConfig functions, but allows to
+// choose the method of selection of the checked combinations of priorities, which
+// allows to check configurations with a large quantity of priorities.
+//
+// Methods of the checker are not safe for concurrent use.
+type Checker struct {
+	opts CheckerOpts
+
+	priorities []uint
+	reference  uint
+
+	// Stored combinations for MethodFair and MethodSampling
+	combinations [][]uint
+
+	// Buffers used to avoid memory allocations during the check
+	combination  []uint
+	distribution map[uint]uint
+	referential  map[uint]uint
+
+	// Bit mask of the last combination that did not pass the check for
+	// MethodExhaustive. It is checked first, because with the close values of
+	// quantity of handlers the same combinations usually do not pass the check
+	failed uint64
+}
+
+// Creates checker.
+func NewChecker(opts CheckerOpts) (*Checker, error) {
+	opts = opts.normalize()
+
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	priorities := createSortedCopy(opts.Priorities)
+
+	chk := &Checker{
+		opts: opts,
+
+		priorities: priorities,
+		reference:  referenceFactor * common.SumPriorities(priorities),
+
+		combination:  make([]uint, 0, len(priorities)),
+		distribution: make(map[uint]uint, len(priorities)),
+		referential:  make(map[uint]uint, len(priorities)),
+	}
+
+	switch opts.Method {
+	case MethodFair:
+		chk.combinations = genPrefixCombinations(priorities)
+	case MethodSampling:
+		chk.combinations = genSampledCombinations(
+			priorities,
+			CalcSamplesQuantity(opts.Confidence, opts.Tolerance),
+			opts.Seed,
+		)
+	}
+
+	return chk, nil
+}
+
+// Calculates the quantity of random combinations that must pass the check so that,
+// with the specified probability (confidence), the fraction of combinations that do
+// not pass the check does not exceed the specified tolerance.
+//
+// If the fraction of failed combinations is equal to tolerance, then the
+// probability that none of n random combinations fails is (1 - tolerance)^n, so n
+// is chosen so that this probability does not exceed 1 - confidence.
+func CalcSamplesQuantity(confidence float64, tolerance float64) uint {
+	if confidence <= 0 || confidence >= 1 || tolerance <= 0 || tolerance >= 1 {
+		return 0
+	}
+
+	return uint(math.Ceil(math.Log(1-confidence) / math.Log(1-tolerance)))
+}
+
+// Returns the quantity of combinations checked for each quantity of handlers.
+func (chk *Checker) CombinationsQuantity() uint64 {
+	if chk.opts.Method == MethodExhaustive {
+		return 1<<len(chk.priorities) - 1
+	}
+
+	return uint64(len(chk.combinations))
+}
+
+// Analyzes the quality of distribution of the specified quantity of handlers like
+// the Analyze function, but only for the combinations of priorities checked by
+// the checker. Thus, unlike the Analyze function, it is applicable to a large
+// quantity of priorities if the method is not MethodExhaustive.
+func (chk *Checker) Analyze(quantity uint) Report {
+	if chk.opts.Method == MethodExhaustive {
+		return Analyze(chk.priorities, chk.opts.Divider, quantity)
+	}
+
+	combinations := slices.Clone(chk.combinations)
+
+	// Random combinations may repeat each other and the combinations made up of
+	// the highest priorities, so duplicates are removed after sorting
+	slices.SortStableFunc(combinations, compareCombinations)
+	combinations = slices.CompactFunc(combinations, slices.Equal)
+
+	return analyzeCombinations(chk.priorities, combinations, chk.opts.Divider, quantity)
+}
+
+// Checks that with the specified quantity of handlers the distribution error does
+// not cause stop processing of one or more priorities. Analogue of the
+// IsNonFatalConfig function.
+func (chk *Checker) IsNonFatal(quantity uint) bool {
+	return chk.check(
+		func(combination []uint) bool {
+			return chk.isNonFatalCombination(combination, quantity)
+		},
+	)
+}
+
+// Checks that with the specified quantity of handlers the distribution error does
+// not exceed the limit, specified as a percentage. Analogue of the
+// IsSuitableConfig function.
+func (chk *Checker) IsSuitable(quantity uint, limit float64) bool {
+	return chk.check(
+		func(combination []uint) bool {
+			return chk.isSuitableCombination(combination, quantity, limit)
+		},
+	)
+}
+
+// Picks up the minimum quantity of handlers for which the division error does not
+// cause stop processing of one or more priorities. Analogue of the
+// PickUpMinNonFatalQuantity function.
+func (chk *Checker) PickUpMinNonFatalQuantity(maxQuantity uint) uint {
+	if chk.opts.Method == MethodFair {
+		return searchMin(maxQuantity, chk.IsNonFatal)
+	}
+
+	return scanMin(maxQuantity, chk.IsNonFatal)
+}
+
+// Picks up the maximum quantity of handlers for which the division error does not
+// cause stop processing of one or more priorities. Analogue of the
+// PickUpMaxNonFatalQuantity function.
+func (chk *Checker) PickUpMaxNonFatalQuantity(maxQuantity uint) uint {
+	if chk.opts.Method == MethodFair {
+		if maxQuantity != 0 && chk.IsNonFatal(maxQuantity) {
+			return maxQuantity
+		}
+
+		return 0
+	}
+
+	return scanMax(maxQuantity, chk.IsNonFatal)
+}
+
+// Picks up the minimum quantity of handlers for which the division error does not
+// exceed the limit, specified as a percentage. Analogue of the
+// PickUpMinSuitableQuantity function.
+//
+// The suitability of the configuration is not monotonic over the quantity of
+// handlers, so all quantities are checked one by one.
+func (chk *Checker) PickUpMinSuitableQuantity(maxQuantity uint, limit float64) uint {
+	suitable := func(quantity uint) bool {
+		return chk.IsSuitable(quantity, limit)
+	}
+
+	return scanMin(maxQuantity, suitable)
+}
+
+// Picks up the maximum quantity of handlers for which the division error does not
+// exceed the limit, specified as a percentage. Analogue of the
+// PickUpMaxSuitableQuantity function.
+//
+// The suitability of the configuration is not monotonic over the quantity of
+// handlers, so all quantities are checked one by one.
+func (chk *Checker) PickUpMaxSuitableQuantity(maxQuantity uint, limit float64) uint {
+	suitable := func(quantity uint) bool {
+		return chk.IsSuitable(quantity, limit)
+	}
+
+	return scanMax(maxQuantity, suitable)
+}
+
+func (chk *Checker) check(passed func(combination []uint) bool) bool {
+	if chk.opts.Method != MethodExhaustive {
+		for _, combination := range chk.combinations {
+			if !passed(combination) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if chk.failed != 0 && !passed(chk.fillCombination(chk.failed)) {
+		return false
+	}
+
+	for mask := uint64(1); mask < 1<<len(chk.priorities); mask++ {
+		if mask == chk.failed {
+			continue
+		}
+
+		if !passed(chk.fillCombination(mask)) {
+			chk.failed = mask
+			return false
+		}
+	}
+
+	return true
+}
+
+// Priorities are added to combination in order of the bits, so the combination
+// remains sorted.
+func (chk *Checker) fillCombination(mask uint64) []uint {
+	chk.combination = chk.combination[:0]
+
+	for id, priority := range chk.priorities {
+		if mask&(1<<id) == 0 {
+			continue
+		}
+
+		chk.combination = append(chk.combination, priority)
+	}
+
+	return chk.combination
+}
+
+func (chk *Checker) isNonFatalCombination(combination []uint, quantity uint) bool {
+	clear(chk.distribution)
+
+	chk.opts.Divider(combination, quantity, chk.distribution)
+
+	return common.IsDistributionFilled(chk.distribution)
+}
+
+func (chk *Checker) isSuitableCombination(
+	combination []uint,
+	quantity uint,
+	limit float64,
+) bool {
+	if !chk.isNonFatalCombination(combination, quantity) {
+		return false
+	}
+
+	clear(chk.referential)
+
+	chk.opts.Divider(combination, chk.reference, chk.referential)
+
+	return isDistributionSuitable(
+		chk.distribution,
+		chk.referential,
+		quantity,
+		chk.reference,
+		limit,
+	)
+}
+
+// For dividing functions whose result depends only on the quantity of priorities,
+// any combination with the required quantity of priorities can be chosen.
+func genPrefixCombinations(priorities []uint) [][]uint {
+	combinations := make([][]uint, 0, len(priorities))
+
+	for id := range priorities {
+		combinations = append(combinations, slices.Clone(priorities[:id+1]))
+	}
+
+	return combinations
+}
+
+func genSampledCombinations(priorities []uint, quantity uint, seed uint64) [][]uint {
+	combinations := genPrefixCombinations(priorities)
+
+	//nolint:gosec // Cryptographic strength of random numbers is not required here
+	generator := rand.New(rand.NewPCG(seed, seed))
+
+	for range quantity {
+		combination := make([]uint, 0, len(priorities))
+
+		// Each priority is included in the combination with probability 1/2, so all
+		// combinations are equally probable. Empty combination is regenerated
+		for len(combination) == 0 {
+			for _, priority := range priorities {
+				if generator.Uint64()&1 == 0 {
+					continue
+				}
+
+				combination = append(combination, priority)
+			}
+		}
+
+		combinations = append(combinations, combination)
+	}
+
+	return combinations
+}
+
+// Binary search is used, so the predicate must be monotonic.
+func searchMin(maxQuantity uint, passed func(quantity uint) bool) uint {
+	if maxQuantity == 0 || !passed(maxQuantity) {
+		return 0
+	}
+
+	low := uint(1)
+	high := maxQuantity
+
+	for low < high {
+		middle := low + (high-low)/2
+
+		if passed(middle) {
+			high = middle
+			continue
+		}
+
+		low = middle + 1
+	}
+
+	return low
+}
+
+func scanMin(maxQuantity uint, passed func(quantity uint) bool) uint {
+	for quantity := uint(1); quantity <= maxQuantity; quantity++ {
+		if passed(quantity) {
+			return quantity
+		}
+	}
+
+	return 0
+}
+
+func scanMax(maxQuantity uint, passed func(quantity uint) bool) uint {
+	for quantity := maxQuantity; quantity != 0; quantity-- {
+		if passed(quantity) {
+			return quantity
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,368 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckerOptsValidation(t *testing.T) {
+	opts := CheckerOpts{
+		Method:     MethodExhaustive,
+		Priorities: []uint{3, 2, 1},
+	}
+
+	_, err := NewChecker(opts)
+	require.Error(t, err)
+
+	opts = CheckerOpts{
+		Divider: divider.Fair,
+		Method:  MethodExhaustive,
+	}
+
+	_, err = NewChecker(opts)
+	require.Error(t, err)
+
+	opts = CheckerOpts{
+		Divider:    divider.Fair,
+		Priorities: []uint{3, 2, 1},
+	}
+
+	_, err = NewChecker(opts)
+	require.Error(t, err)
+
+	opts = CheckerOpts{
+		Divider:    divider.Fair,
+		Method:     MethodExhaustive,
+		Priorities: createPriorities(64),
+	}
+
+	_, err = NewChecker(opts)
+	require.Error(t, err)
+
+	opts = CheckerOpts{
+		Confidence: 1,
+		Divider:    divider.Fair,
+		Method:     MethodSampling,
+		Priorities: []uint{3, 2, 1},
+	}
+
+	_, err = NewChecker(opts)
+	require.Error(t, err)
+
+	opts = CheckerOpts{
+		Divider:    divider.Fair,
+		Method:     MethodSampling,
+		Priorities: []uint{3, 2, 1},
+		Tolerance:  -1,
+	}
+
+	_, err = NewChecker(opts)
+	require.Error(t, err)
+
+	opts = CheckerOpts{
+		Divider:    divider.Rate,
+		Method:     MethodFair,
+		Priorities: []uint{3, 2, 1},
+	}
+
+	_, err = NewChecker(opts)
+	require.ErrorIs(t, err, ErrDividerNotFair)
+
+	opts = CheckerOpts{
+		Divider:    divider.Fair,
+		Method:     MethodSampling,
+		Priorities: []uint{3, 2, 1},
+	}
+
+	_, err = NewChecker(opts)
+	require.NoError(t, err)
+}
+
+func TestCalcSamplesQuantity(t *testing.T) {
+	require.Equal(t, uint(0), CalcSamplesQuantity(0, 0.1))
+	require.Equal(t, uint(0), CalcSamplesQuantity(1, 0.1))
+	require.Equal(t, uint(0), CalcSamplesQuantity(0.9, 0))
+	require.Equal(t, uint(0), CalcSamplesQuantity(0.9, 1))
+	require.Equal(t, uint(22), CalcSamplesQuantity(0.9, 0.1))
+	require.Equal(t, uint(299), CalcSamplesQuantity(0.95, 0.01))
+	require.Equal(t, uint(4603), CalcSamplesQuantity(DefaultConfidence, DefaultTolerance))
+}
+
+func TestCheckerCombinationsQuantity(t *testing.T) {
+	priorities := createPriorities(10)
+
+	opts := CheckerOpts{
+		Divider:    divider.Fair,
+		Method:     MethodExhaustive,
+		Priorities: priorities,
+	}
+
+	checker, err := NewChecker(opts)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1023), checker.CombinationsQuantity())
+
+	opts.Method = MethodFair
+
+	checker, err = NewChecker(opts)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), checker.CombinationsQuantity())
+
+	opts.Method = MethodSampling
+
+	checker, err = NewChecker(opts)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10+4603), checker.CombinationsQuantity())
+}
+
+func TestCheckerExhaustive(t *testing.T) {
+	testCheckerExact(t, MethodExhaustive, divider.Fair, []uint{3, 2, 1})
+	testCheckerExact(t, MethodExhaustive, divider.Rate, []uint{3, 2, 1})
+	testCheckerExact(t, MethodExhaustive, divider.Fair, []uint{70, 20, 10})
+	testCheckerExact(t, MethodExhaustive, divider.Rate, []uint{70, 20, 10})
+	testCheckerExact(t, MethodExhaustive, divider.Rate, []uint{46, 40, 38, 29, 25, 13, 12})
+	testCheckerExact(t, MethodExhaustive, divider.Rate, []uint{42, 38, 32, 30, 16, 9, 7})
+	testCheckerExact(t, MethodExhaustive, divider.Rate, createPriorities(8))
+}
+
+func TestCheckerFair(t *testing.T) {
+	testCheckerExact(t, MethodFair, divider.Fair, []uint{3, 2, 1})
+	testCheckerExact(t, MethodFair, divider.Fair, []uint{70, 20, 10})
+	testCheckerExact(t, MethodFair, divider.Fair, []uint{46, 40, 38, 29, 25, 13, 12})
+	testCheckerExact(t, MethodFair, divider.Fair, createPriorities(8))
+}
+
+func testCheckerExact(
+	t *testing.T,
+	method Method,
+	divider divider.Divider,
+	priorities []uint,
+) {
+	const (
+		maxQuantity = 200
+		limit       = 10.0
+	)
+
+	opts := CheckerOpts{
+		Divider:    divider,
+		Method:     method,
+		Priorities: priorities,
+	}
+
+	checker, err := NewChecker(opts)
+	require.NoError(t, err)
+
+	for quantity := uint(1); quantity <= maxQuantity; quantity++ {
+		require.Equal(
+			t,
+			IsNonFatalConfig(priorities, divider, quantity),
+			checker.IsNonFatal(quantity),
+			"priorities: %v, quantity: %v",
+			priorities,
+			quantity,
+		)
+
+		require.Equal(
+			t,
+			IsSuitableConfig(priorities, divider, quantity, limit),
+			checker.IsSuitable(quantity, limit),
+			"priorities: %v, quantity: %v",
+			priorities,
+			quantity,
+		)
+	}
+
+	for _, maxQuantity := range []uint{0, 1, 5, 10, maxQuantity} {
+		require.Equal(
+			t,
+			PickUpMinNonFatalQuantity(priorities, divider, maxQuantity),
+			checker.PickUpMinNonFatalQuantity(maxQuantity),
+			"priorities: %v, max quantity: %v",
+			priorities,
+			maxQuantity,
+		)
+
+		require.Equal(
+			t,
+			PickUpMaxNonFatalQuantity(priorities, divider, maxQuantity),
+			checker.PickUpMaxNonFatalQuantity(maxQuantity),
+			"priorities: %v, max quantity: %v",
+			priorities,
+			maxQuantity,
+		)
+
+		require.Equal(
+			t,
+			PickUpMinSuitableQuantity(priorities, divider, maxQuantity, limit),
+			checker.PickUpMinSuitableQuantity(maxQuantity, limit),
+			"priorities: %v, max quantity: %v",
+			priorities,
+			maxQuantity,
+		)
+
+		require.Equal(
+			t,
+			PickUpMaxSuitableQuantity(priorities, divider, maxQuantity, limit),
+			checker.PickUpMaxSuitableQuantity(maxQuantity, limit),
+			"priorities: %v, max quantity: %v",
+			priorities,
+			maxQuantity,
+		)
+	}
+}
+
+func TestCheckerSampling(t *testing.T) {
+	priorities := createPriorities(8)
+
+	opts := CheckerOpts{
+		Divider:    divider.Rate,
+		Method:     MethodSampling,
+		Priorities: priorities,
+	}
+
+	checker, err := NewChecker(opts)
+	require.NoError(t, err)
+
+	// With 255 combinations of 8 priorities and default confidence and tolerance
+	// all combinations are almost certainly sampled
+	for quantity := uint(1); quantity <= 100; quantity++ {
+		require.Equal(
+			t,
+			IsNonFatalConfig(priorities, divider.Rate, quantity),
+			checker.IsNonFatal(quantity),
+			"quantity: %v",
+			quantity,
+		)
+
+		require.Equal(
+			t,
+			IsSuitableConfig(priorities, divider.Rate, quantity, 10),
+			checker.IsSuitable(quantity, 10),
+			"quantity: %v",
+			quantity,
+		)
+	}
+}
+
+func TestCheckerManyPriorities(t *testing.T) {
+	priorities := createPriorities(30)
+
+	opts := CheckerOpts{
+		Divider:    divider.Fair,
+		Method:     MethodFair,
+		Priorities: priorities,
+	}
+
+	checker, err := NewChecker(opts)
+	require.NoError(t, err)
+
+	require.Equal(t, uint(30), checker.PickUpMinNonFatalQuantity(1000))
+	require.Equal(t, uint(1000), checker.PickUpMaxNonFatalQuantity(1000))
+	require.Equal(t, uint(0), checker.PickUpMinNonFatalQuantity(29))
+	require.Equal(t, uint(0), checker.PickUpMaxNonFatalQuantity(29))
+
+	opts = CheckerOpts{
+		Divider:    divider.Rate,
+		Method:     MethodSampling,
+		Priorities: priorities,
+	}
+
+	checker, err = NewChecker(opts)
+	require.NoError(t, err)
+
+	minimum := checker.PickUpMinNonFatalQuantity(1000)
+	require.NotZero(t, minimum)
+	require.True(t, checker.IsNonFatal(minimum))
+	require.False(t, checker.IsNonFatal(minimum-1))
+}
+
+func TestCheckerAnalyze(t *testing.T) {
+	priorities := []uint{46, 40, 38, 29, 25, 13, 12}
+
+	opts := CheckerOpts{
+		Divider:    divider.Rate,
+		Method:     MethodExhaustive,
+		Priorities: priorities,
+	}
+
+	checker, err := NewChecker(opts)
+	require.NoError(t, err)
+	require.Equal(t, Analyze(priorities, divider.Rate, 10), checker.Analyze(10))
+
+	opts = CheckerOpts{
+		Divider:    divider.Fair,
+		Method:     MethodFair,
+		Priorities: priorities,
+	}
+
+	checker, err = NewChecker(opts)
+	require.NoError(t, err)
+
+	report := checker.Analyze(10)
+	require.Equal(t, uint(10), report.Quantity)
+	require.Len(t, report.Combinations, len(priorities))
+
+	for id, combination := range report.Combinations {
+		require.Equal(t, priorities[:id+1], combination.Priorities)
+	}
+}
+
+func TestCheckerAnalyzeManyPriorities(t *testing.T) {
+	priorities := createPriorities(30)
+
+	opts := CheckerOpts{
+		Divider:    divider.Rate,
+		Method:     MethodSampling,
+		Priorities: priorities,
+	}
+
+	checker, err := NewChecker(opts)
+	require.NoError(t, err)
+
+	report := checker.Analyze(100)
+	require.NotEmpty(t, report.Combinations)
+	require.LessOrEqual(t, uint64(len(report.Combinations)), checker.CombinationsQuantity())
+
+	for id := 1; id < len(report.Combinations); id++ {
+		require.Negative(
+			t,
+			compareCombinations(
+				report.Combinations[id-1].Priorities,
+				report.Combinations[id].Priorities,
+			),
+		)
+	}
+
+	require.Equal(t, !checker.IsNonFatal(100), report.Worst(1)[0].Fatal)
+}
+
+func BenchmarkCheckerExhaustive(b *testing.B) {
+	benchmarkChecker(b, MethodExhaustive, 12)
+}
+
+func BenchmarkCheckerFair(b *testing.B) {
+	benchmarkChecker(b, MethodFair, 30)
+}
+
+func BenchmarkCheckerSampling(b *testing.B) {
+	benchmarkChecker(b, MethodSampling, 30)
+}
+
+func benchmarkChecker(b *testing.B, method Method, prioritiesQuantity int) {
+	opts := CheckerOpts{
+		Divider:    divider.Fair,
+		Method:     method,
+		Priorities: createPriorities(prioritiesQuantity),
+	}
+
+	checker, err := NewChecker(opts)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+
+	for range b.N {
+		_ = checker.IsSuitable(uint(prioritiesQuantity)*10, 10)
+	}
+}
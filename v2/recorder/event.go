@@ -0,0 +1,59 @@
+package recorder
+
+import (
+	"time"
+)
+
+// Kind of the recorded event.
+type Kind int
+
+const (
+	// Handler has completed work with the data element, that is, it has informed
+	// the discipline that the data element is no longer used.
+	KindCompleted Kind = iota + 1
+	// Handler has processed the data element, but has not yet informed the
+	// discipline about it.
+	KindProcessed
+	// Handler has received the data element from the discipline.
+	KindReceived
+)
+
+const (
+	kindCompletedName = "completed"
+	kindProcessedName = "processed"
+	kindReceivedName  = "received"
+	kindUnknownName   = "unknown"
+)
+
+// Returns the name of the event kind.
+func (knd Kind) String() string {
+	switch knd {
+	case KindCompleted:
+		return kindCompletedName
+	case KindProcessed:
+		return kindProcessedName
+	case KindReceived:
+		return kindReceivedName
+	}
+
+	return kindUnknownName
+}
+
+// Event recorded while the discipline is running.
+type Event struct {
+	// Identifier of the data element (of the slice for join disciplines), which is
+	// the same in all events of the data element and unique within the priority.
+	// Recorder wrappers number the data elements in the order of receipt and match
+	// the Release() calls with them in the same order. The cqostest.Measurer uses
+	// the values of the data elements, which are their sequence numbers
+	Data uint
+	// Kind of the event
+	Kind Kind
+	// Priority of the data element. Always zero for disciplines without priorities
+	Priority uint
+	// Quantity of data elements in the slice for join disciplines. Always zero for
+	// other disciplines
+	Quantity uint
+	// Time elapsed from the start of the recorder to the event
+	RelativeTime time.Duration
+}
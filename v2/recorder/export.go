@@ -0,0 +1,80 @@
+package recorder
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+type jsonEvent struct {
+	Data         uint   `json:"data"`
+	Kind         string `json:"kind"`
+	Priority     uint   `json:"priority"`
+	Quantity     uint   `json:"quantity"`
+	RelativeTime int64  `json:"relativeTime"`
+}
+
+// Writes stored events to the writer in JSON lines format, one event per line.
+//
+// Relative time is written in nanoseconds.
+func (rcr *Recorder) WriteJSONLines(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+
+	for _, event := range rcr.Events() {
+		converted := jsonEvent{
+			Data:         event.Data,
+			Kind:         event.Kind.String(),
+			Priority:     event.Priority,
+			Quantity:     event.Quantity,
+			RelativeTime: int64(event.RelativeTime),
+		}
+
+		if err := encoder.Encode(converted); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Writes stored events to the writer in CSV format with a header line.
+//
+// Relative time is written in nanoseconds.
+func (rcr *Recorder) WriteCSV(writer io.Writer) error {
+	const (
+		base = 10
+	)
+
+	header := []string{
+		"data",
+		"kind",
+		"priority",
+		"quantity",
+		"relativeTime",
+	}
+
+	encoder := csv.NewWriter(writer)
+
+	if err := encoder.Write(header); err != nil {
+		return err
+	}
+
+	for _, event := range rcr.Events() {
+		record := []string{
+			strconv.FormatUint(uint64(event.Data), base),
+			event.Kind.String(),
+			strconv.FormatUint(uint64(event.Priority), base),
+			strconv.FormatUint(uint64(event.Quantity), base),
+			strconv.FormatInt(int64(event.RelativeTime), base),
+		}
+
+		if err := encoder.Write(record); err != nil {
+			return err
+		}
+	}
+
+	encoder.Flush()
+
+	return encoder.Error()
+}
@@ -0,0 +1,63 @@
+package recorder
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createFilledRecorder() *Recorder {
+	rcr := New(Opts{})
+
+	rcr.events = append(
+		rcr.events,
+		Event{
+			Data:         1,
+			Kind:         KindReceived,
+			Priority:     2,
+			Quantity:     3,
+			RelativeTime: time.Microsecond,
+		},
+		Event{
+			Data:         4,
+			Kind:         KindCompleted,
+			Priority:     5,
+			RelativeTime: time.Millisecond,
+		},
+	)
+
+	return rcr
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	rcr := createFilledRecorder()
+
+	buffer := &bytes.Buffer{}
+
+	err := rcr.WriteJSONLines(buffer)
+	require.NoError(t, err)
+
+	expected := `{"data":1,"kind":"received","priority":2,"quantity":3,"relativeTime":1000}
+{"data":4,"kind":"completed","priority":5,"quantity":0,"relativeTime":1000000}
+`
+
+	require.Equal(t, expected, buffer.String())
+}
+
+func TestWriteCSV(t *testing.T) {
+	rcr := createFilledRecorder()
+
+	buffer := &bytes.Buffer{}
+
+	err := rcr.WriteCSV(buffer)
+	require.NoError(t, err)
+
+	expected := `data,kind,priority,quantity,relativeTime
+1,received,2,3,1000
+4,completed,5,0,1000000
+`
+
+	require.Equal(t, expected, buffer.String())
+}
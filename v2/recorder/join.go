@@ -0,0 +1,118 @@
+package recorder
+
+import (
+	"sync"
+)
+
+// Describes the join disciplines, for example, join.Discipline or
+// unite.Discipline.
+type JoinDiscipline[Type any] interface {
+	Output() <-chan []Type
+	Release()
+}
+
+// Options of the created wrapper of the join discipline.
+type JoinOpts[Type any] struct {
+	// Wrapped discipline
+	Discipline JoinDiscipline[Type]
+	// Recorder to which the events are written
+	Recorder *Recorder
+}
+
+func (opts JoinOpts[Type]) isValid() error {
+	if opts.Discipline == nil {
+		return ErrDisciplineEmpty
+	}
+
+	if opts.Recorder == nil {
+		return ErrRecorderEmpty
+	}
+
+	return nil
+}
+
+// Wrapper of the join discipline that records the events.
+//
+// The KindReceived event, with the quantity of data elements in the slice, is
+// recorded when a slice is passed to the output channel, just before it is
+// received by the consumer, so it always precedes the other events of the slice.
+// If the wrapped discipline is used with the NoCopy option, then the KindProcessed
+// event is recorded when the Release() method is called and the KindCompleted
+// event is recorded when the Release() method of the wrapped discipline is
+// returned.
+type Join[Type any] struct {
+	opts JoinOpts[Type]
+
+	output chan []Type
+
+	mutex    *sync.Mutex
+	releases uint
+}
+
+// Creates wrapper and starts recording.
+//
+// After creation, the wrapper must be used instead of the wrapped discipline.
+func NewJoin[Type any](opts JoinOpts[Type]) (*Join[Type], error) {
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	wrp := &Join[Type]{
+		opts: opts,
+
+		// Output channel is unbuffered so that the recorded time is close to the time
+		// of receipt of the slice by the consumer
+		output: make(chan []Type),
+
+		mutex: &sync.Mutex{},
+	}
+
+	go wrp.main()
+
+	return wrp, nil
+}
+
+// Returns output channel.
+//
+// If this channel is closed, it means that the wrapped discipline is terminated.
+func (wrp *Join[Type]) Output() <-chan []Type {
+	return wrp.output
+}
+
+// Marks accumulated slice as no longer used.
+//
+// Must be used only if wrapped discipline is used with the NoCopy option.
+func (wrp *Join[Type]) Release() {
+	sequence := wrp.nextRelease()
+
+	wrp.opts.Recorder.record(KindProcessed, 0, sequence, 0)
+	wrp.opts.Discipline.Release()
+	wrp.opts.Recorder.record(KindCompleted, 0, sequence, 0)
+}
+
+func (wrp *Join[Type]) nextRelease() uint {
+	wrp.mutex.Lock()
+	defer wrp.mutex.Unlock()
+
+	sequence := wrp.releases
+
+	wrp.releases++
+
+	return sequence
+}
+
+func (wrp *Join[Type]) main() {
+	defer close(wrp.output)
+
+	sequence := uint(0)
+
+	for join := range wrp.opts.Discipline.Output() {
+		// Event is recorded before sending so that the consumer cannot release
+		// the slice before its receipt is recorded
+		wrp.opts.Recorder.record(KindReceived, 0, sequence, uint(len(join)))
+
+		sequence++
+
+		wrp.output <- join
+	}
+}
@@ -0,0 +1,96 @@
+package recorder
+
+import (
+	"testing"
+
+	"github.com/akramarenkov/cqos/v2/join"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinOptsValidation(t *testing.T) {
+	opts := JoinOpts[int]{
+		Recorder: New(Opts{}),
+	}
+
+	_, err := NewJoin(opts)
+	require.Error(t, err)
+
+	discipline, err := join.New(join.Opts[int]{Input: make(chan int), JoinSize: 1})
+	require.NoError(t, err)
+
+	opts = JoinOpts[int]{
+		Discipline: discipline,
+	}
+
+	_, err = NewJoin(opts)
+	require.Error(t, err)
+}
+
+func TestJoin(t *testing.T) {
+	testJoin(t, false)
+	testJoin(t, true)
+}
+
+func testJoin(t *testing.T, noCopy bool) {
+	quantity := 27
+	input := make(chan int, quantity)
+
+	joinOpts := join.Opts[int]{
+		Input:    input,
+		JoinSize: 10,
+		NoCopy:   noCopy,
+	}
+
+	discipline, err := join.New(joinOpts)
+	require.NoError(t, err, "no copy: %v", noCopy)
+
+	rcr := New(Opts{})
+
+	opts := JoinOpts[int]{
+		Discipline: discipline,
+		Recorder:   rcr,
+	}
+
+	wrapper, err := NewJoin(opts)
+	require.NoError(t, err, "no copy: %v", noCopy)
+
+	for item := range quantity {
+		input <- item
+	}
+
+	close(input)
+
+	for range wrapper.Output() {
+		if noCopy {
+			wrapper.Release()
+		}
+	}
+
+	quantities := make([]uint, 0)
+	kinds := make(map[Kind]int)
+
+	for _, event := range rcr.Events() {
+		kinds[event.Kind]++
+
+		if event.Kind == KindReceived {
+			quantities = append(quantities, event.Quantity)
+		}
+	}
+
+	require.Equal(t, []uint{10, 10, 7}, quantities, "no copy: %v", noCopy)
+
+	requireReceivedFirst(t, rcr.Events())
+
+	if noCopy {
+		require.Equal(
+			t,
+			map[Kind]int{KindReceived: 3, KindProcessed: 3, KindCompleted: 3},
+			kinds,
+		)
+
+		return
+	}
+
+	require.Equal(t, map[Kind]int{KindReceived: 3}, kinds)
+}
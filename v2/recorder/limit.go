@@ -0,0 +1,78 @@
+package recorder
+
+// Describes the limit discipline, for example, limit.Discipline.
+type LimitDiscipline[Type any] interface {
+	Output() <-chan Type
+}
+
+// Options of the created wrapper of the limit discipline.
+type LimitOpts[Type any] struct {
+	// Wrapped discipline
+	Discipline LimitDiscipline[Type]
+	// Recorder to which the events are written
+	Recorder *Recorder
+}
+
+func (opts LimitOpts[Type]) isValid() error {
+	if opts.Discipline == nil {
+		return ErrDisciplineEmpty
+	}
+
+	if opts.Recorder == nil {
+		return ErrRecorderEmpty
+	}
+
+	return nil
+}
+
+// Wrapper of the limit discipline that records the events.
+//
+// The KindReceived event is recorded when a data element is received from the
+// output channel.
+type Limit[Type any] struct {
+	opts LimitOpts[Type]
+
+	output chan Type
+}
+
+// Creates wrapper and starts recording.
+//
+// After creation, the wrapper must be used instead of the wrapped discipline.
+func NewLimit[Type any](opts LimitOpts[Type]) (*Limit[Type], error) {
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	wrp := &Limit[Type]{
+		opts: opts,
+
+		// Output channel is unbuffered so that the time of receipt of the data
+		// element by the consumer is recorded
+		output: make(chan Type),
+	}
+
+	go wrp.main()
+
+	return wrp, nil
+}
+
+// Returns output channel.
+//
+// If this channel is closed, it means that the wrapped discipline is terminated.
+func (wrp *Limit[Type]) Output() <-chan Type {
+	return wrp.output
+}
+
+func (wrp *Limit[Type]) main() {
+	defer close(wrp.output)
+
+	sequence := uint(0)
+
+	for item := range wrp.opts.Discipline.Output() {
+		wrp.output <- item
+
+		wrp.opts.Recorder.record(KindReceived, 0, sequence, 0)
+
+		sequence++
+	}
+}
@@ -0,0 +1,86 @@
+package recorder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/limit"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitOptsValidation(t *testing.T) {
+	opts := LimitOpts[int]{
+		Recorder: New(Opts{}),
+	}
+
+	_, err := NewLimit(opts)
+	require.Error(t, err)
+
+	limitOpts := limit.Opts[int]{
+		Input: make(chan int),
+		Limit: limit.Rate{
+			Interval: time.Second,
+			Quantity: 1,
+		},
+	}
+
+	discipline, err := limit.New(limitOpts)
+	require.NoError(t, err)
+
+	opts = LimitOpts[int]{
+		Discipline: discipline,
+	}
+
+	_, err = NewLimit(opts)
+	require.Error(t, err)
+}
+
+func TestLimit(t *testing.T) {
+	quantity := 100
+	input := make(chan int, quantity)
+
+	limitOpts := limit.Opts[int]{
+		Input: input,
+		Limit: limit.Rate{
+			Interval: 10 * time.Millisecond,
+			Quantity: 10,
+		},
+	}
+
+	discipline, err := limit.New(limitOpts)
+	require.NoError(t, err)
+
+	rcr := New(Opts{})
+
+	opts := LimitOpts[int]{
+		Discipline: discipline,
+		Recorder:   rcr,
+	}
+
+	wrapper, err := NewLimit(opts)
+	require.NoError(t, err)
+
+	for item := range quantity {
+		input <- item
+	}
+
+	close(input)
+
+	outSequence := make([]int, 0, quantity)
+
+	for item := range wrapper.Output() {
+		outSequence = append(outSequence, item)
+	}
+
+	events := rcr.Events()
+	require.Len(t, events, quantity)
+
+	for id, event := range events {
+		require.Equal(t, KindReceived, event.Kind)
+		require.Equal(t, uint(id), event.Data)
+		require.Equal(t, id, outSequence[id])
+	}
+
+	require.GreaterOrEqual(t, events[len(events)-1].RelativeTime, 90*time.Millisecond)
+}
@@ -0,0 +1,128 @@
+package recorder
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/akramarenkov/cqos/v2/priority/types"
+)
+
+var (
+	ErrDisciplineEmpty = errors.New("discipline was not specified")
+	ErrRecorderEmpty   = errors.New("recorder was not specified")
+)
+
+// Describes the prioritization discipline, for example, priority.Discipline.
+type PriorityDiscipline[Type any] interface {
+	Output() <-chan types.Prioritized[Type]
+	Release(priority uint)
+	Err() <-chan error
+}
+
+// Options of the created wrapper of the prioritization discipline.
+type PriorityOpts[Type any] struct {
+	// Wrapped discipline
+	Discipline PriorityDiscipline[Type]
+	// Recorder to which the events are written
+	Recorder *Recorder
+}
+
+func (opts PriorityOpts[Type]) isValid() error {
+	if opts.Discipline == nil {
+		return ErrDisciplineEmpty
+	}
+
+	if opts.Recorder == nil {
+		return ErrRecorderEmpty
+	}
+
+	return nil
+}
+
+// Wrapper of the prioritization discipline that records the events.
+//
+// The KindReceived event is recorded when a data element is passed to the output
+// channel, just before it is received by the handler, so it always precedes
+// the other events of the data element. The KindProcessed event is recorded when the
+// Release() method is called and the KindCompleted event is recorded when the
+// Release() method of the wrapped discipline is returned.
+type Priority[Type any] struct {
+	opts PriorityOpts[Type]
+
+	output chan types.Prioritized[Type]
+
+	mutex    *sync.Mutex
+	releases map[uint]uint
+}
+
+// Creates wrapper and starts recording.
+//
+// After creation, the wrapper must be used instead of the wrapped discipline.
+func NewPriority[Type any](opts PriorityOpts[Type]) (*Priority[Type], error) {
+	if err := opts.isValid(); err != nil {
+		return nil, err
+	}
+
+	wrp := &Priority[Type]{
+		opts: opts,
+
+		// Output channel is unbuffered so that the recorded time is close to the time
+		// of receipt of the data element by the handler
+		output: make(chan types.Prioritized[Type]),
+
+		mutex:    &sync.Mutex{},
+		releases: make(map[uint]uint),
+	}
+
+	go wrp.main()
+
+	return wrp, nil
+}
+
+// Returns output channel.
+//
+// If this channel is closed, it means that the wrapped discipline is terminated.
+func (wrp *Priority[Type]) Output() <-chan types.Prioritized[Type] {
+	return wrp.output
+}
+
+// Marks that current data has been processed and handler is ready to receive new data.
+func (wrp *Priority[Type]) Release(priority uint) {
+	sequence := wrp.nextRelease(priority)
+
+	wrp.opts.Recorder.record(KindProcessed, priority, sequence, 0)
+	wrp.opts.Discipline.Release(priority)
+	wrp.opts.Recorder.record(KindCompleted, priority, sequence, 0)
+}
+
+// Returns a channel with errors of the wrapped discipline.
+func (wrp *Priority[Type]) Err() <-chan error {
+	return wrp.opts.Discipline.Err()
+}
+
+func (wrp *Priority[Type]) nextRelease(priority uint) uint {
+	wrp.mutex.Lock()
+	defer wrp.mutex.Unlock()
+
+	sequence := wrp.releases[priority]
+
+	wrp.releases[priority]++
+
+	return sequence
+}
+
+func (wrp *Priority[Type]) main() {
+	defer close(wrp.output)
+
+	sequences := make(map[uint]uint)
+
+	for item := range wrp.opts.Discipline.Output() {
+		// Event is recorded before sending so that the handler cannot release
+		// the data element before its receipt is recorded
+		wrp.opts.Recorder.record(KindReceived, item.Priority, sequences[item.Priority], 0)
+
+		sequences[item.Priority]++
+
+		wrp.output <- item
+	}
+}
@@ -0,0 +1,129 @@
+package recorder
+
+import (
+	"testing"
+
+	"github.com/akramarenkov/cqos/v2/priority"
+	"github.com/akramarenkov/cqos/v2/priority/divider"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityOptsValidation(t *testing.T) {
+	opts := PriorityOpts[int]{
+		Recorder: New(Opts{}),
+	}
+
+	_, err := NewPriority(opts)
+	require.Error(t, err)
+
+	opts = PriorityOpts[int]{
+		Discipline: createPriority(t, nil),
+	}
+
+	_, err = NewPriority(opts)
+	require.Error(t, err)
+}
+
+func createPriority(t *testing.T, inputs map[uint]chan int) *priority.Discipline[int] {
+	if inputs == nil {
+		inputs = map[uint]chan int{
+			1: make(chan int),
+		}
+	}
+
+	inputsOpts := make(map[uint]<-chan int, len(inputs))
+
+	for priority, channel := range inputs {
+		inputsOpts[priority] = channel
+	}
+
+	opts := priority.Opts[int]{
+		Divider:          divider.Fair,
+		HandlersQuantity: 6,
+		Inputs:           inputsOpts,
+	}
+
+	discipline, err := priority.New(opts)
+	require.NoError(t, err)
+
+	return discipline
+}
+
+func TestPriority(t *testing.T) {
+	quantity := 100
+
+	inputs := map[uint]chan int{
+		3: make(chan int, quantity),
+		2: make(chan int, quantity),
+		1: make(chan int, quantity),
+	}
+
+	rcr := New(Opts{})
+
+	opts := PriorityOpts[int]{
+		Discipline: createPriority(t, inputs),
+		Recorder:   rcr,
+	}
+
+	wrapper, err := NewPriority(opts)
+	require.NoError(t, err)
+
+	for _, input := range inputs {
+		for item := range quantity {
+			input <- item
+		}
+
+		close(input)
+	}
+
+	received := make(map[uint]int)
+
+	for item := range wrapper.Output() {
+		received[item.Priority]++
+
+		wrapper.Release(item.Priority)
+	}
+
+	require.NoError(t, <-wrapper.Err())
+
+	kinds := make(map[Kind]map[uint]int)
+
+	for _, event := range rcr.Events() {
+		if kinds[event.Kind] == nil {
+			kinds[event.Kind] = make(map[uint]int)
+		}
+
+		kinds[event.Kind][event.Priority]++
+	}
+
+	expected := map[uint]int{3: quantity, 2: quantity, 1: quantity}
+
+	require.Equal(t, expected, received)
+	require.Equal(t, expected, kinds[KindReceived])
+	require.Equal(t, expected, kinds[KindProcessed])
+	require.Equal(t, expected, kinds[KindCompleted])
+
+	requireReceivedFirst(t, rcr.Events())
+}
+
+// Checks that the events of each data element are recorded after its receipt.
+func requireReceivedFirst(t *testing.T, events []Event) {
+	type key struct {
+		data     uint
+		priority uint
+	}
+
+	received := make(map[key]bool)
+
+	for _, event := range events {
+		key := key{data: event.Data, priority: event.Priority}
+
+		if event.Kind == KindReceived {
+			received[key] = true
+			continue
+		}
+
+		require.True(t, received[key], "event: %+v", event)
+	}
+}
@@ -0,0 +1,130 @@
+// Recorder of the events that occur while the disciplines are running. Wraps
+// priority, join and limit disciplines, records timestamped events with bounded
+// memory and exports them in JSON lines or CSV formats for offline analysis.
+package recorder
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// Default value of Capacity option if it is not specified.
+	DefaultCapacity = 1 << 20
+)
+
+const (
+	initialCapacity = 1 << 10
+)
+
+// Options of the created recorder.
+type Opts struct {
+	// Maximum quantity of stored events. When it is reached, the oldest events are
+	// overwritten by new ones. A zero value means that the DefaultCapacity is used
+	Capacity uint
+}
+
+func (opts Opts) normalize() Opts {
+	if opts.Capacity == 0 {
+		opts.Capacity = DefaultCapacity
+	}
+
+	return opts
+}
+
+// Recorder of the events.
+//
+// Methods of the recorder are safe for concurrent use.
+type Recorder struct {
+	opts Opts
+
+	startedAt time.Time
+
+	mutex   *sync.Mutex
+	events  []Event
+	next    int
+	dropped uint64
+}
+
+// Creates recorder. Relative time of the events is counted from this moment.
+func New(opts Opts) *Recorder {
+	opts = opts.normalize()
+
+	rcr := &Recorder{
+		opts: opts,
+
+		startedAt: time.Now(),
+
+		mutex:  &sync.Mutex{},
+		events: make([]Event, 0, min(opts.Capacity, initialCapacity)),
+	}
+
+	return rcr
+}
+
+// Returns the time at which the recorder was started.
+func (rcr *Recorder) StartedAt() time.Time {
+	return rcr.startedAt
+}
+
+// Returns the quantity of events that were overwritten due to the capacity limit.
+func (rcr *Recorder) Dropped() uint64 {
+	rcr.mutex.Lock()
+	defer rcr.mutex.Unlock()
+
+	return rcr.dropped
+}
+
+// Returns a copy of the stored events in the order in which they were recorded.
+func (rcr *Recorder) Events() []Event {
+	rcr.mutex.Lock()
+	defer rcr.mutex.Unlock()
+
+	events := make([]Event, 0, len(rcr.events))
+
+	events = append(events, rcr.events[rcr.next:]...)
+	events = append(events, rcr.events[:rcr.next]...)
+
+	return events
+}
+
+// Removes all stored events and resets the counter of the dropped events.
+//
+// Relative time of the events is still counted from the start of the recorder.
+func (rcr *Recorder) Reset() {
+	rcr.mutex.Lock()
+	defer rcr.mutex.Unlock()
+
+	rcr.events = rcr.events[:0]
+	rcr.next = 0
+	rcr.dropped = 0
+}
+
+func (rcr *Recorder) record(kind Kind, priority uint, data uint, quantity uint) {
+	event := Event{
+		Data:         data,
+		Kind:         kind,
+		Priority:     priority,
+		Quantity:     quantity,
+		RelativeTime: time.Since(rcr.startedAt),
+	}
+
+	rcr.mutex.Lock()
+	defer rcr.mutex.Unlock()
+
+	// Integer overflow is impossible because the length of the events slice never
+	// exceeds the Capacity option
+	if uint(len(rcr.events)) < rcr.opts.Capacity {
+		rcr.events = append(rcr.events, event)
+		return
+	}
+
+	rcr.events[rcr.next] = event
+	rcr.dropped++
+
+	rcr.next++
+
+	if rcr.next == len(rcr.events) {
+		rcr.next = 0
+	}
+}
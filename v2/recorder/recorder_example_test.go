@@ -0,0 +1,79 @@
+package recorder_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/akramarenkov/cqos/v2/join"
+	"github.com/akramarenkov/cqos/v2/recorder"
+)
+
+func ExampleNewJoin() {
+	data := []int{
+		1, 2, 3, 4, 5, 6, 7, 8,
+		9, 10, 11, 12, 13, 14, 15, 16,
+		17, 18, 19, 20, 21, 22, 23, 24,
+		25, 26, 27,
+	}
+
+	input := make(chan int, 10)
+
+	joinOpts := join.Opts[int]{
+		Input:    input,
+		JoinSize: 10,
+		Timeout:  time.Second,
+	}
+
+	discipline, err := join.New(joinOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	rcr := recorder.New(recorder.Opts{})
+
+	opts := recorder.JoinOpts[int]{
+		Discipline: discipline,
+		Recorder:   rcr,
+	}
+
+	// Wrapper is used instead of the wrapped discipline
+	wrapper, err := recorder.NewJoin(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	go func() {
+		defer close(input)
+
+		for _, item := range data {
+			input <- item
+		}
+	}()
+
+	for join := range wrapper.Output() {
+		fmt.Println(join)
+	}
+
+	buffer := &bytes.Buffer{}
+
+	if err := rcr.WriteCSV(buffer); err != nil {
+		panic(err)
+	}
+
+	// Relative time is omitted because it differs from run to run
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		fields := strings.Split(line, ",")
+		fmt.Println(strings.Join(fields[:len(fields)-1], ","))
+	}
+
+	// Output:
+	// [1 2 3 4 5 6 7 8 9 10]
+	// [11 12 13 14 15 16 17 18 19 20]
+	// [21 22 23 24 25 26 27]
+	// data,kind,priority,quantity
+	// 0,received,0,10
+	// 1,received,0,10
+	// 2,received,0,7
+}
@@ -0,0 +1,74 @@
+package recorder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	rcr := New(Opts{Capacity: 3})
+
+	require.Empty(t, rcr.Events())
+	require.Equal(t, uint64(0), rcr.Dropped())
+
+	rcr.record(KindReceived, 1, 0, 0)
+	rcr.record(KindProcessed, 1, 0, 0)
+
+	events := rcr.Events()
+	require.Len(t, events, 2)
+	require.Equal(t, KindReceived, events[0].Kind)
+	require.Equal(t, KindProcessed, events[1].Kind)
+	require.Equal(t, uint64(0), rcr.Dropped())
+
+	rcr.record(KindCompleted, 1, 0, 0)
+	rcr.record(KindReceived, 2, 0, 0)
+	rcr.record(KindProcessed, 2, 0, 0)
+
+	events = rcr.Events()
+	require.Len(t, events, 3)
+	require.Equal(t, uint64(2), rcr.Dropped())
+
+	require.Equal(t, KindCompleted, events[0].Kind)
+	require.Equal(t, uint(1), events[0].Priority)
+	require.Equal(t, KindReceived, events[1].Kind)
+	require.Equal(t, uint(2), events[1].Priority)
+	require.Equal(t, KindProcessed, events[2].Kind)
+	require.Equal(t, uint(2), events[2].Priority)
+
+	require.LessOrEqual(t, events[0].RelativeTime, events[1].RelativeTime)
+	require.LessOrEqual(t, events[1].RelativeTime, events[2].RelativeTime)
+
+	rcr.Reset()
+
+	require.Empty(t, rcr.Events())
+	require.Equal(t, uint64(0), rcr.Dropped())
+
+	rcr.record(KindReceived, 3, 0, 0)
+
+	events = rcr.Events()
+	require.Len(t, events, 1)
+	require.Equal(t, uint(3), events[0].Priority)
+}
+
+func TestRecorderDefaultCapacity(t *testing.T) {
+	rcr := New(Opts{})
+	require.Equal(t, uint(DefaultCapacity), rcr.opts.Capacity)
+}
+
+func TestKindString(t *testing.T) {
+	require.Equal(t, "completed", KindCompleted.String())
+	require.Equal(t, "processed", KindProcessed.String())
+	require.Equal(t, "received", KindReceived.String())
+	require.Equal(t, "unknown", Kind(0).String())
+}
+
+func BenchmarkRecord(b *testing.B) {
+	rcr := New(Opts{Capacity: 1 << 10})
+
+	b.ResetTimer()
+
+	for range b.N {
+		rcr.record(KindReceived, 1, 0, 0)
+	}
+}